@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -29,8 +30,13 @@ type ContainersService struct {
 
 // ContainersServicesList ...
 func (c *Client) ContainersServicesList() ([]ContainersService, error) {
+	return c.ContainersServicesListCtx(context.Background())
+}
+
+// ContainersServicesListCtx is ContainersServicesList with a context
+func (c *Client) ContainersServicesListCtx(ctx context.Context) ([]ContainersService, error) {
 	var contlist []string
-	e := c.OVHClient.Get("/caas/containers", &contlist)
+	e := c.get(ctx, "/caas/containers", &contlist)
 	containersservices := []ContainersService{}
 	for _, cont := range contlist {
 		containersservices = append(containersservices, ContainersService{Name: cont})
@@ -40,9 +46,14 @@ func (c *Client) ContainersServicesList() ([]ContainersService, error) {
 
 // ContainersServiceInfo ...
 func (c *Client) ContainersServiceInfo(containerservid string) (*ContainersService, error) {
+	return c.ContainersServiceInfoCtx(context.Background(), containerservid)
+}
+
+// ContainersServiceInfoCtx is ContainersServiceInfo with a context
+func (c *Client) ContainersServiceInfoCtx(ctx context.Context, containerservid string) (*ContainersService, error) {
 	containersservice := &ContainersService{}
 	path := fmt.Sprintf("/caas/containers/%s", url.QueryEscape(containerservid))
-	e := c.OVHClient.Get(path, &containersservice)
+	e := c.get(ctx, path, &containersservice)
 
 	return containersservice, e
 }
@@ -0,0 +1,31 @@
+package ovh_test
+
+import (
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestContainersServices(t *testing.T) {
+	server, client := newTestServer(t)
+	service := server.AddContainersService(ovh.ContainersService{Cluster: "paris-1", Frameworks: []string{"marathon"}})
+
+	services, err := client.ContainersServicesList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 1 || services[0].Name != service.Name {
+		t.Fatalf("unexpected containers services %+v", services)
+	}
+
+	info, err := client.ContainersServiceInfo(service.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Cluster != "paris-1" || info.State != "READY" || len(info.Frameworks) != 1 {
+		t.Fatalf("unexpected containers service %+v", info)
+	}
+
+	_, err = client.ContainersServiceInfo("unknown")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,312 @@
+package ovh
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces the secrets scrubbed from cassettes
+const Redacted = "REDACTED"
+
+// redactedHeaders are the headers scrubbed from cassettes
+var redactedHeaders = []string{"X-Ovh-Application", "X-Ovh-Signature", "X-Ovh-Consumer", "Authorization", "X-Auth-Token", "X-Subject-Token"}
+
+// redactedFields are the JSON fields scrubbed from the bodies saved in cassettes
+var redactedFields = []string{"password", "consumerKey", "secret", "secretKey", "token"}
+
+// ErrNotRecorded is returned in replay mode for requests missing from the cassette
+var ErrNotRecorded = errors.New("request not recorded in the cassette")
+
+// Cassette is a record of the requests made to the API and of their responses
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request saved in a cassette
+type RecordedRequest struct {
+	Method  string              `json:"method"`
+	Path    string              `json:"path"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    string              `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response saved in a cassette
+type RecordedResponse struct {
+	Status  int                 `json:"status"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    string              `json:"body,omitempty"`
+}
+
+// WithRecord saves every request made by the client and its response to a
+// cassette file, with application and consumer keys, signatures and passwords
+// redacted.
+func WithRecord(path string) Option {
+	return func(o *clientOptions) {
+		o.record = path
+	}
+}
+
+// WithReplay serves the responses of a cassette file saved with WithRecord
+// instead of calling the API. Each recorded response is served once, in the
+// order of the record; requests missing from the cassette fail with
+// ErrNotRecorded.
+func WithReplay(path string) Option {
+	return func(o *clientOptions) {
+		o.replay = path
+	}
+}
+
+// LoadCassette reads a cassette file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err = json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("Invalid cassette %s: %s", path, err)
+	}
+	return cassette, nil
+}
+
+// Save writes a cassette file
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// recorder is a transport saving the interactions to a cassette file
+type recorder struct {
+	path      string
+	endpoint  string
+	transport http.RoundTripper
+
+	mutex    sync.Mutex
+	cassette Cassette
+}
+
+// newRecorder returns a transport wrapping transport, starting a new cassette at path
+func newRecorder(path, endpoint string, transport http.RoundTripper) (*recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &recorder{path: path, endpoint: endpoint, transport: transport, cassette: Cassette{Interactions: []Interaction{}}}
+	// fail early when the file cannot be written
+	return r, r.cassette.Save(path)
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, reqBody, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := readBody(&res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			Path:    strings.TrimPrefix(req.URL.String(), r.endpoint),
+			Headers: scrubHeaders(req.Header),
+			Body:    scrubBody(reqBody),
+		},
+		Response: RecordedResponse{
+			Status:  res.StatusCode,
+			Headers: scrubHeaders(res.Header),
+			Body:    scrubBody(resBody),
+		},
+	})
+	// saved on each call, the CLI may exit anytime
+	if err = r.cassette.Save(r.path); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// replayer is a transport serving the responses of a cassette
+type replayer struct {
+	endpoint string
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// newReplayer returns a transport serving the responses of the cassette at path
+func newReplayer(path, endpoint string) (*replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &replayer{endpoint: endpoint, cassette: cassette, used: make([]bool, len(cassette.Interactions))}, nil
+}
+
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	body = scrubBody(body)
+	// paths are relative to the endpoint, which may differ from the record
+	path := strings.TrimPrefix(req.URL.String(), r.endpoint)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request.Method != req.Method || interaction.Request.Path != path {
+			continue
+		}
+		if interaction.Request.Body == body {
+			match = i
+			break
+		}
+		if match == -1 {
+			match = i
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, path, ErrNotRecorded)
+	}
+	r.used[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(recorded.Headers),
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// requestBody returns the body of req, and the request to send in place of
+// req. The request of the caller is left untouched: its body is read from a
+// copy given by GetBody, or from a clone of the request.
+func requestBody(req *http.Request) (*http.Request, string, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, "", err
+		}
+		data, err := readBody(&body)
+		return req, data, err
+	}
+	clone := req.Clone(req.Context())
+	data, err := readBody(&clone.Body)
+	return clone, data, err
+}
+
+// readBody reads a body and replaces it with a copy still readable
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
+
+// scrubHeaders returns a copy of headers with the secrets redacted
+func scrubHeaders(headers http.Header) map[string][]string {
+	res := map[string][]string{}
+	for name, values := range headers {
+		res[name] = values
+	}
+	for _, name := range redactedHeaders {
+		if _, ok := res[name]; ok {
+			res[name] = []string{Redacted}
+		}
+	}
+	return res
+}
+
+// scrubBody returns a JSON body with the secret fields redacted. Other bodies
+// are returned as is.
+func scrubBody(body string) string {
+	var v interface{}
+	if body == "" || json.Unmarshal([]byte(body), &v) != nil {
+		return body
+	}
+	if !scrubValue(v) {
+		return body
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
+
+// scrubValue redacts the secret fields of a decoded JSON value, and tells if
+// any was found
+func scrubValue(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isRedactedField(key) {
+				if s, ok := value.(string); ok && s != "" {
+					v[key] = Redacted
+					found = true
+				}
+				continue
+			}
+			found = scrubValue(value) || found
+		}
+	case []interface{}:
+		for _, value := range v {
+			found = scrubValue(value) || found
+		}
+	}
+	return found
+}
+
+func isRedactedField(key string) bool {
+	for _, field := range redactedFields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package ovh_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhtest"
+)
+
+func TestRecordReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	server, _ := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3"})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3"})
+
+	client, err := server.Client(ovh.WithRecord(cassette))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := client.CloudCreateInstance(project.ID, "web-1", "", flavor.ID, image.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := client.CloudProjectUserCreate(project.ID, "terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{ovhtest.AppKey, ovhtest.ConsumerKey, ovhtest.AppSecret, user.Password, "$1$"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("the cassette contains the secret %q", secret)
+		}
+	}
+
+	// no server, no credentials: everything comes from the cassette
+	replay, err := ovh.New(ovh.WithEndpoint("http://replay.example.com"), ovh.WithReplay(cassette))
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := replay.CloudCreateInstance(project.ID, "web-1", "", flavor.ID, image.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.ID != recorded.ID || replayed.Status != recorded.Status {
+		t.Fatalf("expected %+v, got %+v", recorded, replayed)
+	}
+	replayedUser, err := replay.CloudProjectUserCreate(project.ID, "terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayedUser.Username != user.Username || replayedUser.Password != ovh.Redacted {
+		t.Fatalf("unexpected replayed user %+v", replayedUser)
+	}
+
+	// each response is served once
+	if _, err = replay.CloudCreateInstance(project.ID, "web-1", "", flavor.ID, image.ID, "GRA3"); !errors.Is(err, ovh.ErrNotRecorded) {
+		t.Fatalf("expected ErrNotRecorded, got %v", err)
+	}
+}
+
+func TestRecordAndReplayAreExclusive(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	if _, err := ovh.New(ovh.WithEndpoint("ovh-eu"), ovh.WithRecord(cassette), ovh.WithReplay(cassette)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := ovh.New(ovh.WithEndpoint("ovh-eu"), ovh.WithReplay(cassette)); err == nil {
+		t.Fatal("expected an error for a missing cassette")
+	}
+}
@@ -1,30 +1,422 @@
 package ovh
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	govh "github.com/ovh/go-ovh/ovh"
 )
 
-var instance *Client
+var (
+	// instance is the shared default client returned by NewClient
+	instance      *Client
+	instanceMutex sync.Mutex
+)
 
-// Client ...
+// Client is an OVH API client. A Client is safe for concurrent use by
+// multiple goroutines, and clients built with New are independent from
+// each other: several accounts or endpoints can be used in one process.
 type Client struct {
 	OVHClient *govh.Client
+
+	// endpoint is the API URL, used to build and sign requests
+	endpoint string
+
+	// userAgent is sent with every request when not empty
+	userAgent string
+
+	// concurrency and partialResults drive FetchDetails
+	concurrency    int
+	partialResults bool
+
+	// retries and retryMaxWait drive the retries of failed requests
+	retries      int
+	retryMaxWait time.Duration
+
+	// timeDelta between this host and the API, computed once
+	timeDeltaMutex sync.Mutex
+	timeDeltaDone  bool
+	timeDelta      time.Duration
+}
+
+// clientOptions holds the settings of a Client under construction
+type clientOptions struct {
+	profile     string
+	endpoint    string
+	appKey      string
+	appSecret   string
+	consumerKey string
+	httpClient  *http.Client
+	timeout     time.Duration
+	userAgent   string
+
+	concurrency    int
+	partialResults bool
+
+	retries      int
+	retryMaxWait time.Duration
+
+	record string
+	replay string
+}
+
+// Option configures a Client built with New
+type Option func(*clientOptions)
+
+// WithProfile reads endpoint and keys from a named profile of the
+// configuration files. Other options take precedence over the profile.
+func WithProfile(profile string) Option {
+	return func(o *clientOptions) {
+		o.profile = profile
+	}
 }
 
-//NewClient initialize a client
+// WithEndpoint sets the API endpoint, either a name such as "ovh-eu" or an URL
+func WithEndpoint(endpoint string) Option {
+	return func(o *clientOptions) {
+		o.endpoint = endpoint
+	}
+}
+
+// WithKeys sets the application key, application secret and consumer key
+func WithKeys(appKey, appSecret, consumerKey string) Option {
+	return func(o *clientOptions) {
+		o.appKey = appKey
+		o.appSecret = appSecret
+		o.consumerKey = consumerKey
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to run the requests. The client
+// is copied, so its settings are not modified by the timeout option.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout of each HTTP request, 180s by default
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithConcurrency sets the maximum number of concurrent requests used to
+// fetch the details of listed items, DefaultConcurrency by default
+func WithConcurrency(concurrency int) Option {
+	return func(o *clientOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithPartialResults makes listings with details return the items that could
+// be fetched along with a *DetailsError, instead of failing on the first error
+func WithPartialResults(partial bool) Option {
+	return func(o *clientOptions) {
+		o.partialResults = partial
+	}
+}
+
+// New returns a new independent client. Settings not given as options are
+// loaded from environment variables and configuration files, like go-ovh does.
+func New(opts ...Option) (*Client, error) {
+	o := &clientOptions{
+		retries:      DefaultRetries,
+		retryMaxWait: DefaultRetryMaxWait,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.profile != "" {
+		p, err := LoadProfile(o.profile)
+		if err != nil {
+			return nil, err
+		}
+		if o.endpoint == "" {
+			o.endpoint = p.Endpoint
+		}
+		if o.appKey == "" {
+			o.appKey = p.ApplicationKey
+		}
+		if o.appSecret == "" {
+			o.appSecret = p.ApplicationSecret
+		}
+		if o.consumerKey == "" {
+			o.consumerKey = p.ConsumerKey
+		}
+	}
+
+	if o.endpoint == "" {
+		o.endpoint = getConfigValue(loadConfig(), "default", "endpoint", "ovh-eu")
+	}
+	endpoint, err := resolveEndpoint(o.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.replay != "" {
+		// replays need no credentials, nothing reaches the API
+		for _, key := range []*string{&o.appKey, &o.appSecret, &o.consumerKey} {
+			if *key == "" {
+				*key = Redacted
+			}
+		}
+	}
+
+	c, err := govh.NewClient(endpoint, o.appKey, o.appSecret, o.consumerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		*httpClient = *o.httpClient
+	}
+	switch {
+	case o.timeout != 0:
+		httpClient.Timeout = o.timeout
+	case httpClient.Timeout == 0:
+		httpClient.Timeout = govh.DefaultTimeout
+	}
+	switch {
+	case o.record != "" && o.replay != "":
+		return nil, errors.New("A client cannot record and replay at the same time")
+	case o.record != "":
+		if httpClient.Transport, err = newRecorder(o.record, endpoint, httpClient.Transport); err != nil {
+			return nil, err
+		}
+	case o.replay != "":
+		if httpClient.Transport, err = newReplayer(o.replay, endpoint); err != nil {
+			return nil, err
+		}
+	}
+	// go-ovh sets the timeout on every call it runs itself, keep them in sync
+	c.Client = httpClient
+	c.Timeout = httpClient.Timeout
+
+	return &Client{
+		OVHClient: c,
+		endpoint:  endpoint,
+		userAgent: o.userAgent,
+
+		concurrency:    o.concurrency,
+		partialResults: o.partialResults,
+
+		retries:      o.retries,
+		retryMaxWait: o.retryMaxWait,
+	}, nil
+}
+
+// NewClient returns the shared default client, configured from environment
+// variables and configuration files. It is created on the first call and
+// reused afterwards; use New to get independent clients.
 func NewClient() (*Client, error) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+
 	if instance != nil {
 		return instance, nil
 	}
 
-	c, err := govh.NewDefaultClient()
+	c, err := New()
 	if err != nil {
-		return nil, fmt.Errorf("Error while creating OVH Client: %s\nYou need to create an application; please visite this page https://eu.api.ovh.com/createApp/ and create your $HOME/ovh.conf file\n\t[default]\n\t; general configuration: default endpoint\n\tendpoint=ovh-eu\n\n\t[ovh-eu]\n\t; configuration specific to 'ovh-eu' endpoint\n\tapplication_key=my_app_key", err)
+		return nil, fmt.Errorf(configErrorFormat, err)
 	}
-	instance := &Client{}
-	instance.OVHClient = c
+	instance = c
 
 	return instance, nil
 }
+
+// configErrorFormat explains how to configure the client when it cannot be created
+const configErrorFormat = "Error while creating OVH Client: %s\nYou need to create an application; please visite this page https://eu.api.ovh.com/createApp/ and create your $HOME/ovh.conf file\n\t[default]\n\t; general configuration: default endpoint\n\tendpoint=ovh-eu\n\n\t[ovh-eu]\n\t; configuration specific to 'ovh-eu' endpoint\n\tapplication_key=my_app_key"
+
+// NewClientWithProfile initialize a client for a named profile of the
+// configuration files. An empty profile without options is the shared
+// default client.
+func NewClientWithProfile(profile string, opts ...Option) (*Client, error) {
+	if profile == "" && len(opts) == 0 {
+		return NewClient()
+	}
+
+	c, err := New(append(opts, WithProfile(profile))...)
+	if err != nil && profile != "" {
+		return nil, fmt.Errorf("Error while creating OVH Client for profile %s: %s", profile, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(configErrorFormat, err)
+	}
+	return c, nil
+}
+
+// CallAPICtx is the context aware counterpart of go-ovh CallAPI. It signs the
+// request the same way, but the request is bound to ctx: cancelling ctx or
+// reaching its deadline aborts the HTTP call.
+//
+// Requests failing on a network error, a rate limit or a server error are
+// retried, see WithRetries.
+func (c *Client) CallAPICtx(ctx context.Context, method, path string, reqBody, resType interface{}, needAuth bool) error {
+	var body []byte
+	var err error
+
+	if reqBody != nil {
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if e := ctx.Err(); e != nil {
+			return e
+		}
+
+		var retryAfter time.Duration
+		retryAfter, err = c.callAPIOnce(ctx, method, path, body, resType, needAuth)
+		if err == nil || attempt >= c.retries || !isRetryable(ctx, method, err) {
+			return err
+		}
+
+		select {
+		case <-time.After(c.retryWait(attempt, retryAfter)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// callAPIOnce runs a single attempt of a request. It returns the delay asked
+// by the API in a Retry-After header, if any.
+func (c *Client) callAPIOnce(ctx context.Context, method, path string, body []byte, resType interface{}, needAuth bool) (time.Duration, error) {
+	req, err := http.NewRequest(method, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json;charset=utf-8")
+	}
+	req.Header.Add("X-Ovh-Application", c.OVHClient.AppKey)
+	req.Header.Add("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if needAuth {
+		timeDelta, err := c.getTimeDelta(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		timestamp := time.Now().Add(-timeDelta).Unix()
+
+		req.Header.Add("X-Ovh-Timestamp", strconv.FormatInt(timestamp, 10))
+		req.Header.Add("X-Ovh-Consumer", c.OVHClient.ConsumerKey)
+
+		h := sha1.New()
+		h.Write([]byte(fmt.Sprintf("%s+%s+%s+%s%s+%s+%d",
+			c.OVHClient.AppSecret,
+			c.OVHClient.ConsumerKey,
+			method,
+			c.endpoint,
+			path,
+			body,
+			timestamp,
+		)))
+		req.Header.Add("X-Ovh-Signature", fmt.Sprintf("$1$%x", h.Sum(nil)))
+	}
+
+	response, err := c.OVHClient.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseRetryAfter(response.Header.Get("Retry-After")), getResponse(response, resType)
+}
+
+// getResponse checks the response and unmarshals it into resType if needed
+func getResponse(response *http.Response, resType interface{}) error {
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		apiError := &Error{APIError: &govh.APIError{Code: response.StatusCode}}
+		if err = json.Unmarshal(body, apiError.APIError); err != nil {
+			// proxies in front of the API may answer with a non JSON body
+			apiError.Message = string(body)
+		} else {
+			var details struct {
+				Class     string `json:"class"`
+				ErrorCode string `json:"errorCode"`
+			}
+			json.Unmarshal(body, &details)
+			apiError.Class, apiError.ErrorCode = details.Class, details.ErrorCode
+		}
+		apiError.QueryID = response.Header.Get("X-Ovh-QueryID")
+		if response.Request != nil {
+			apiError.Method, apiError.Path = response.Request.Method, response.Request.URL.Path
+		}
+		return apiError
+	}
+
+	if len(body) == 0 || resType == nil {
+		return nil
+	}
+
+	return json.Unmarshal(body, resType)
+}
+
+// getTimeDelta returns the time delta between the host and the API. It is
+// only computed once, but retried on the next call if it failed.
+func (c *Client) getTimeDelta(ctx context.Context) (time.Duration, error) {
+	c.timeDeltaMutex.Lock()
+	defer c.timeDeltaMutex.Unlock()
+
+	if !c.timeDeltaDone {
+		var timestamp int64
+		if err := c.CallAPICtx(ctx, "GET", "/auth/time", nil, &timestamp, false); err != nil {
+			return 0, err
+		}
+		c.timeDelta = time.Since(time.Unix(timestamp, 0))
+		c.timeDeltaDone = true
+	}
+
+	return c.timeDelta, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, resType interface{}) error {
+	return c.CallAPICtx(ctx, "GET", path, nil, resType, true)
+}
+
+func (c *Client) post(ctx context.Context, path string, reqBody, resType interface{}) error {
+	return c.CallAPICtx(ctx, "POST", path, reqBody, resType, true)
+}
+
+func (c *Client) put(ctx context.Context, path string, reqBody, resType interface{}) error {
+	return c.CallAPICtx(ctx, "PUT", path, reqBody, resType, true)
+}
+
+func (c *Client) delete(ctx context.Context, path string, resType interface{}) error {
+	return c.CallAPICtx(ctx, "DELETE", path, nil, resType, true)
+}
@@ -0,0 +1,101 @@
+package ovh
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newDomainServer serves /auth/time, /domain and /domain/{name} for n domains
+func newDomainServer(n int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/auth/time":
+			json.NewEncoder(w).Encode(1500000000)
+		case r.URL.Path == "/domain":
+			names := []string{}
+			for i := 0; i < n; i++ {
+				names = append(names, fmt.Sprintf("domain-%d.com", i))
+			}
+			json.NewEncoder(w).Encode(names)
+		case strings.HasPrefix(r.URL.Path, "/domain/"):
+			json.NewEncoder(w).Encode(Domain{Domain: strings.TrimPrefix(r.URL.Path, "/domain/"), Offer: "gold"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"message": "not found"})
+		}
+	}))
+}
+
+func TestNewIndependentClients(t *testing.T) {
+	a, err := New(WithEndpoint("http://a.example.com"), WithKeys("ak", "as", "ck"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(WithEndpoint("ovh-ca"), WithKeys("ak2", "as2", "ck2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a == b || a.OVHClient == b.OVHClient || a.OVHClient.Client == b.OVHClient.Client {
+		t.Fatal("New must return independent clients")
+	}
+	if a.endpoint != "http://a.example.com" || b.endpoint != "https://ca.api.ovh.com/1.0" {
+		t.Fatalf("unexpected endpoints %s and %s", a.endpoint, b.endpoint)
+	}
+}
+
+func TestNewWithHTTPClientIsCopied(t *testing.T) {
+	hc := &http.Client{}
+	c, err := New(WithEndpoint("ovh-eu"), WithKeys("ak", "as", "ck"), WithHTTPClient(hc), WithTimeout(42*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hc.Timeout != 0 {
+		t.Fatal("the given HTTP client must not be modified")
+	}
+	if c.OVHClient.Client.Timeout != 42*time.Second {
+		t.Fatalf("expected timeout to be 42s, got %s", c.OVHClient.Client.Timeout)
+	}
+}
+
+func TestConcurrentDomainListWithDetails(t *testing.T) {
+	server := newDomainServer(50)
+	defer server.Close()
+
+	clients := []*Client{}
+	for i := 0; i < 2; i++ {
+		c, err := New(WithEndpoint(server.URL), WithKeys("ak", "as", "ck"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		clients = append(clients, c)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			domains, err := c.DomainList(true)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(domains) != 50 {
+				t.Errorf("expected 50 domains, got %d", len(domains))
+			}
+			for _, d := range domains {
+				if d.Offer != "gold" {
+					t.Errorf("domain %s has no details", d.Domain)
+				}
+			}
+		}(clients[i%len(clients)])
+	}
+	wg.Wait()
+}
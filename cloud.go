@@ -1,12 +1,12 @@
 package ovh
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
 	"time"
-
-	"github.com/ovh/go-ovh/ovh"
 )
 
 const (
@@ -51,6 +51,8 @@ type Flavor struct {
 	Type              string `json:"type,omitempty"`
 	InboundBandwidth  int    `json:"inboundBandwidth,omitempty"`
 	OutboundBandwidth int    `json:"outboundBandwidth,omitempty"`
+	// PlanCodes give the prices of the flavor in the catalog, see CloudCatalog
+	PlanCodes *FlavorPlanCodes `json:"planCodes,omitempty"`
 }
 
 // SshkeyReq defines the fields for an SSH Key upload
@@ -84,15 +86,18 @@ type Region struct {
 
 // Network is a go representation of a Cloud IP address
 type Network struct {
-	ID      string `json:"id,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Regions []struct {
-		Region string `json:"region,omitempty"`
-		Status string `json:"status,omitempty"`
-	} `json:"regions,omitempty"`
+	ID      string          `json:"id,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Regions []NetworkRegion `json:"regions,omitempty"`
+	Status  string          `json:"status,omitempty"`
+	Type    string          `json:"type,omitempty"`
+	VlanID  int             `json:"vlanId,omitempty"`
+}
+
+// NetworkRegion is the status of a network in a region
+type NetworkRegion struct {
+	Region string `json:"region,omitempty"`
 	Status string `json:"status,omitempty"`
-	Type   string `json:"type,omitempty"`
-	VlanID int    `json:"vlanId,omitempty"`
 }
 
 // IP is a go representation of a Cloud IP address
@@ -104,6 +109,8 @@ type IP struct {
 }
 
 // InstanceReq defines the fields for a VM creation
+//
+// Deprecated: use InstanceCreateOpts, with the fields the API expects.
 type InstanceReq struct {
 	Name     string `json:"name,omitempty"`
 	FlavorID string `json:"flavorID,omitempty"`
@@ -114,16 +121,22 @@ type InstanceReq struct {
 
 // Instance is a go representation of Cloud instance
 type Instance struct {
-	Name           string  `json:"name,omitempty"`
-	ID             string  `json:"id,omitempty"`
-	Status         string  `json:"status,omitempty"`
-	Created        string  `json:"created,omitempty"`
-	Region         string  `json:"region,omitemptyn"`
-	Image          *Image  `json:"image,omitempty"`
-	Flavor         *Flavor `json:"flavor,omitempty"`
-	Sshkey         *Sshkey `json:"sshKey,omitempty"`
-	IPAddresses    []IP    `json:"ipAddresses,omitempty"`
-	MonthlyBilling *string `json:"monthlyBilling,omitempty"`
+	Name           string                  `json:"name,omitempty"`
+	ID             string                  `json:"id,omitempty"`
+	Status         string                  `json:"status,omitempty"`
+	Created        string                  `json:"created,omitempty"`
+	Region         string                  `json:"region,omitemptyn"`
+	Image          *Image                  `json:"image,omitempty"`
+	Flavor         *Flavor                 `json:"flavor,omitempty"`
+	Sshkey         *Sshkey                 `json:"sshKey,omitempty"`
+	IPAddresses    []IP                    `json:"ipAddresses,omitempty"`
+	MonthlyBilling *InstanceMonthlyBilling `json:"monthlyBilling,omitempty"`
+}
+
+// InstanceMonthlyBilling is the monthly billing of an instance
+type InstanceMonthlyBilling struct {
+	Since  string `json:"since,omitempty"`
+	Status string `json:"status,omitempty"`
 }
 
 // User is a go representation of Cloud user instance
@@ -134,6 +147,7 @@ type User struct {
 	Description  string    `json:"description"`
 	Username     string    `json:"username"`
 	Password     string    `json:"password"`
+	Roles        []Role    `json:"roles,omitempty"`
 }
 
 // RebootReq defines the fields for a VM reboot
@@ -143,9 +157,14 @@ type RebootReq struct {
 
 // CloudProjectsList returns a list of string project ID
 func (c *Client) CloudProjectsList() ([]Project, error) {
+	return c.CloudProjectsListCtx(context.Background())
+}
+
+// CloudProjectsListCtx is CloudProjectsList with a context
+func (c *Client) CloudProjectsListCtx(ctx context.Context) ([]Project, error) {
 	projects := []Project{}
 	ids := []string{}
-	e := c.OVHClient.Get("/cloud/project", &ids)
+	e := c.get(ctx, "/cloud/project", &ids)
 	if e != nil {
 		return nil, e
 	}
@@ -157,17 +176,27 @@ func (c *Client) CloudProjectsList() ([]Project, error) {
 
 // CloudProjectInfoByID return the details of a project given a project id
 func (c *Client) CloudProjectInfoByID(projectID string) (*Project, error) {
+	return c.CloudProjectInfoByIDCtx(context.Background(), projectID)
+}
+
+// CloudProjectInfoByIDCtx is CloudProjectInfoByID with a context
+func (c *Client) CloudProjectInfoByIDCtx(ctx context.Context, projectID string) (*Project, error) {
 	project := &Project{}
 	path := fmt.Sprintf("/cloud/project/%s", url.QueryEscape(projectID))
-	e := c.OVHClient.Get(path, &project)
+	e := c.get(ctx, path, &project)
 
 	return project, e
 }
 
 // CloudProjectInfoByName returns the details of a project given its name.
 func (c *Client) CloudProjectInfoByName(projectName string) (project *Project, err error) {
+	return c.CloudProjectInfoByNameCtx(context.Background(), projectName)
+}
+
+// CloudProjectInfoByNameCtx is CloudProjectInfoByName with a context
+func (c *Client) CloudProjectInfoByNameCtx(ctx context.Context, projectName string) (project *Project, err error) {
 	// get project list
-	projects, err := c.CloudProjectsList()
+	projects, err := c.CloudProjectsListCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -175,13 +204,13 @@ func (c *Client) CloudProjectInfoByName(projectName string) (project *Project, e
 	// If projectName is a valid projectID return it.
 	for _, p := range projects {
 		if p.ID == projectName {
-			return c.CloudProjectInfoByID(p.ID)
+			return c.CloudProjectInfoByIDCtx(ctx, p.ID)
 		}
 	}
 
 	// Attempt to find a project matching projectName. This is potentially slow
 	for _, p := range projects {
-		project, err := c.CloudProjectInfoByID(p.ID)
+		project, err := c.CloudProjectInfoByIDCtx(ctx, p.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -197,9 +226,14 @@ func (c *Client) CloudProjectInfoByName(projectName string) (project *Project, e
 
 // CloudListRegions return a list of network regions
 func (c *Client) CloudListRegions(projectID string) ([]Region, error) {
+	return c.CloudListRegionsCtx(context.Background(), projectID)
+}
+
+// CloudListRegionsCtx is CloudListRegions with a context
+func (c *Client) CloudListRegionsCtx(ctx context.Context, projectID string) ([]Region, error) {
 	path := fmt.Sprintf("/cloud/project/%s/region", url.QueryEscape(projectID))
 	var resultsreq []string
-	e := c.OVHClient.Get(path, &resultsreq)
+	e := c.get(ctx, path, &resultsreq)
 	regions := []Region{}
 	for _, resultreq := range resultsreq {
 		regions = append(regions, Region{Region: resultreq})
@@ -209,46 +243,56 @@ func (c *Client) CloudListRegions(projectID string) ([]Region, error) {
 
 // CloudInfoRegion return services status on a region
 func (c *Client) CloudInfoRegion(projectID, regionName string) (*Region, error) {
+	return c.CloudInfoRegionCtx(context.Background(), projectID, regionName)
+}
+
+// CloudInfoRegionCtx is CloudInfoRegion with a context
+func (c *Client) CloudInfoRegionCtx(ctx context.Context, projectID, regionName string) (*Region, error) {
 	region := &Region{}
 	path := fmt.Sprintf("/cloud/project/%s/region/%s", url.QueryEscape(projectID), url.QueryEscape(regionName))
-	err := c.OVHClient.Get(path, region)
+	err := c.get(ctx, path, region)
 	return region, err
 }
 
 // CloudGetInstance finds a VM instance given a name or an ID
 func (c *Client) CloudGetInstance(projectID, instanceID string) (instance *Instance, err error) {
+	return c.CloudGetInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudGetInstanceCtx is CloudGetInstance with a context
+func (c *Client) CloudGetInstanceCtx(ctx context.Context, projectID, instanceID string) (instance *Instance, err error) {
 	path := fmt.Sprintf("/cloud/project/%s/instance/%s", url.QueryEscape(projectID), url.QueryEscape(instanceID))
-	err = c.OVHClient.Get(path, &instance)
-	return instance, nil
-}
-
-// CloudCreateInstance start a new public cloud instance and returns resulting object
-// func (c *Client) CloudCreateInstance(instanceReq InstanceReq, projectID string) (instance *Instance, err error) {
-// instanceReq := InstanceReq{
-// 	Name:     name,
-// 	SshkeyID: pubkeyID,
-// 	FlavorID: flavorID,
-// 	ImageID:  imageID,
-// 	Region:   region,
-// }
+	err = c.get(ctx, path, &instance)
+	return instance, err
+}
+
+// CloudCreateInstance start a new public cloud instance and returns resulting
+// object. See CloudCreateInstanceWithOpts for the other settings of instances.
 func (c *Client) CloudCreateInstance(projectID, name, pubkeyID, flavorID, imageID, region string) (instance *Instance, err error) {
-	instanceReq := InstanceReq{
+	return c.CloudCreateInstanceCtx(context.Background(), projectID, name, pubkeyID, flavorID, imageID, region)
+}
+
+// CloudCreateInstanceCtx is CloudCreateInstance with a context
+func (c *Client) CloudCreateInstanceCtx(ctx context.Context, projectID, name, pubkeyID, flavorID, imageID, region string) (instance *Instance, err error) {
+	return c.CloudCreateInstanceWithOptsCtx(ctx, projectID, InstanceCreateOpts{
 		Name:     name,
-		SshkeyID: pubkeyID,
+		SSHKeyID: pubkeyID,
 		FlavorID: flavorID,
 		ImageID:  imageID,
 		Region:   region,
-	}
-	path := fmt.Sprintf("/cloud/project/%s/instance", url.QueryEscape(projectID))
-	err = c.OVHClient.Post(path, instanceReq, &instance)
-	return instance, err
+	})
 }
 
 // CloudDeleteInstance stops and destroys a public cloud instance
 func (c *Client) CloudDeleteInstance(projectID, instanceID string) (err error) {
+	return c.CloudDeleteInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudDeleteInstanceCtx is CloudDeleteInstance with a context
+func (c *Client) CloudDeleteInstanceCtx(ctx context.Context, projectID, instanceID string) (err error) {
 	path := fmt.Sprintf("/cloud/project/%s/instance/%s", url.QueryEscape(projectID), url.QueryEscape(instanceID))
-	err = c.OVHClient.Delete(path, nil)
-	if apierror, ok := err.(*ovh.APIError); ok && apierror.Code == 404 {
+	err = c.delete(ctx, path, nil)
+	if errors.Is(err, ErrNotFound) {
 		err = nil
 	}
 	return err
@@ -256,102 +300,151 @@ func (c *Client) CloudDeleteInstance(projectID, instanceID string) (err error) {
 
 // CloudListInstance show cloud instance(s)
 func (c *Client) CloudListInstance(projectID string) ([]Instance, error) {
+	return c.CloudListInstanceCtx(context.Background(), projectID)
+}
+
+// CloudListInstanceCtx is CloudListInstance with a context
+func (c *Client) CloudListInstanceCtx(ctx context.Context, projectID string) ([]Instance, error) {
 	path := fmt.Sprintf("/cloud/project/%s/instance", url.QueryEscape(projectID))
 	instances := []Instance{}
-	e := c.OVHClient.Get(path, &instances)
+	e := c.get(ctx, path, &instances)
 
 	return instances, e
 }
 
 // CloudInfoInstance give info about cloud instance
 func (c *Client) CloudInfoInstance(projectID, instanceID string) (*Instance, error) {
+	return c.CloudInfoInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudInfoInstanceCtx is CloudInfoInstance with a context
+func (c *Client) CloudInfoInstanceCtx(ctx context.Context, projectID, instanceID string) (*Instance, error) {
 	path := fmt.Sprintf("/cloud/project/%s/instance/%s", url.QueryEscape(projectID), url.QueryEscape(instanceID))
 	instances := &Instance{}
 
-	e := c.OVHClient.Get(path, &instances)
+	e := c.get(ctx, path, &instances)
 
 	return instances, e
 }
 
 // CloudInfoNetworkPublic return the list of a public network by given a project id
 func (c *Client) CloudInfoNetworkPublic(projectID string) ([]Network, error) {
+	return c.CloudInfoNetworkPublicCtx(context.Background(), projectID)
+}
+
+// CloudInfoNetworkPublicCtx is CloudInfoNetworkPublic with a context
+func (c *Client) CloudInfoNetworkPublicCtx(ctx context.Context, projectID string) ([]Network, error) {
 	path := fmt.Sprintf("/cloud/project/%s/network/public", url.QueryEscape(projectID))
 	network := []Network{}
 
-	e := c.OVHClient.Get(path, &network)
+	e := c.get(ctx, path, &network)
 
 	return network, e
 }
 
 // CloudInfoNetworkPrivate return the list of a private network by given a project id
 func (c *Client) CloudInfoNetworkPrivate(projectID string) ([]Network, error) {
+	return c.CloudInfoNetworkPrivateCtx(context.Background(), projectID)
+}
+
+// CloudInfoNetworkPrivateCtx is CloudInfoNetworkPrivate with a context
+func (c *Client) CloudInfoNetworkPrivateCtx(ctx context.Context, projectID string) ([]Network, error) {
 	path := fmt.Sprintf("/cloud/project/%s/network/private", url.QueryEscape(projectID))
 	network := []Network{}
 
-	e := c.OVHClient.Get(path, &network)
+	e := c.get(ctx, path, &network)
 
 	return network, e
 }
 
-// CloudCreateNetworkPrivate create a private network in a vrack
-//func (c *Client) CloudCreateNetworkPrivate(projectID, name string, regions []Regions, vlanid int) (net *Network, err error) {
+// CloudCreateNetworkPrivate create a private network in a vrack, in the comma
+// separated regions, or in all regions if empty. See CloudCreateNetworkPrivateWithOpts.
 func (c *Client) CloudCreateNetworkPrivate(projectID, name, regions string, vlanid int) (net *Network, err error) {
+	return c.CloudCreateNetworkPrivateCtx(context.Background(), projectID, name, regions, vlanid)
+}
 
-	var project Project
-	project.ID = projectID
-	var network Network
-	network.Name = name
-	network.VlanID = vlanid
-	//network.[]Regions = regions
-	path := fmt.Sprintf("/cloud/project/%s/network/private", url.QueryEscape(projectID))
-	err = c.OVHClient.Post(path, network, &net)
-
-	return net, err
+// CloudCreateNetworkPrivateCtx is CloudCreateNetworkPrivate with a context
+func (c *Client) CloudCreateNetworkPrivateCtx(ctx context.Context, projectID, name, regions string, vlanid int) (net *Network, err error) {
+	opts := NetworkPrivateCreateOpts{Name: name, VlanID: vlanid}
+	if regions != "" {
+		opts.Regions = strings.Split(regions, ",")
+	}
+	return c.CloudCreateNetworkPrivateWithOptsCtx(ctx, projectID, opts)
 }
 
 // CloudProjectUsersList return the list of users by given a project id
 func (c *Client) CloudProjectUsersList(projectID string) ([]User, error) {
-	path := fmt.Sprintf("/cloud/project/%s/user", projectID)
+	return c.CloudProjectUsersListCtx(context.Background(), projectID)
+}
+
+// CloudProjectUsersListCtx is CloudProjectUsersList with a context
+func (c *Client) CloudProjectUsersListCtx(ctx context.Context, projectID string) ([]User, error) {
+	path := fmt.Sprintf("/cloud/project/%s/user", url.QueryEscape(projectID))
 	users := []User{}
-	return users, c.OVHClient.Get(path, &users)
+	return users, c.get(ctx, path, &users)
 }
 
 // CloudProjectUserCreate return the list of users by given a project id
 func (c *Client) CloudProjectUserCreate(projectID, description string) (User, error) {
+	return c.CloudProjectUserCreateCtx(context.Background(), projectID, description)
+}
+
+// CloudProjectUserCreateCtx is CloudProjectUserCreate with a context
+func (c *Client) CloudProjectUserCreateCtx(ctx context.Context, projectID, description string) (User, error) {
 	path := fmt.Sprintf("/cloud/project/%s/user", url.QueryEscape(projectID))
 	data := map[string]string{
 		"description": description,
 	}
 	user := User{}
-	return user, c.OVHClient.Post(path, data, &user)
+	return user, c.post(ctx, path, data, &user)
 }
 
 // CloudProjectRegionList return the region by given a project id
 func (c *Client) CloudProjectRegionList(projectID string) ([]string, error) {
+	return c.CloudProjectRegionListCtx(context.Background(), projectID)
+}
+
+// CloudProjectRegionListCtx is CloudProjectRegionList with a context
+func (c *Client) CloudProjectRegionListCtx(ctx context.Context, projectID string) ([]string, error) {
 	path := fmt.Sprintf("/cloud/project/%s/region", url.QueryEscape(projectID))
 	var r []string
-	return r, c.OVHClient.Get(path, &r)
+	return r, c.get(ctx, path, &r)
 }
 
 // CloudProjectSSHKeyList return the list of ssh keys by given a project id
 func (c *Client) CloudProjectSSHKeyList(projectID string) ([]Sshkey, error) {
+	return c.CloudProjectSSHKeyListCtx(context.Background(), projectID)
+}
+
+// CloudProjectSSHKeyListCtx is CloudProjectSSHKeyList with a context
+func (c *Client) CloudProjectSSHKeyListCtx(ctx context.Context, projectID string) ([]Sshkey, error) {
 	path := fmt.Sprintf("/cloud/project/%s/sshkey", url.QueryEscape(projectID))
 	sshkeys := []Sshkey{}
-	return sshkeys, c.OVHClient.Get(path, &sshkeys)
+	return sshkeys, c.get(ctx, path, &sshkeys)
 }
 
 // CloudProjectSSHKeyInfo return info about a ssh keys
 func (c *Client) CloudProjectSSHKeyInfo(projectID, sshkeyID string) (*Sshkey, error) {
+	return c.CloudProjectSSHKeyInfoCtx(context.Background(), projectID, sshkeyID)
+}
+
+// CloudProjectSSHKeyInfoCtx is CloudProjectSSHKeyInfo with a context
+func (c *Client) CloudProjectSSHKeyInfoCtx(ctx context.Context, projectID, sshkeyID string) (*Sshkey, error) {
 	path := fmt.Sprintf("/cloud/project/%s/sshkey/%s", url.QueryEscape(projectID), url.QueryEscape(sshkeyID))
 	sshkeys := &Sshkey{}
-	return sshkeys, c.OVHClient.Get(path, &sshkeys)
+	return sshkeys, c.get(ctx, path, &sshkeys)
 }
 
 // CloudProjectSSHKeyDelete delete a ssh key
 func (c *Client) CloudProjectSSHKeyDelete(projectID, sshkeyID string) (err error) {
+	return c.CloudProjectSSHKeyDeleteCtx(context.Background(), projectID, sshkeyID)
+}
+
+// CloudProjectSSHKeyDeleteCtx is CloudProjectSSHKeyDelete with a context
+func (c *Client) CloudProjectSSHKeyDeleteCtx(ctx context.Context, projectID, sshkeyID string) (err error) {
 	path := fmt.Sprintf("/cloud/project/%s/sshkey/%s", url.QueryEscape(projectID), url.QueryEscape(sshkeyID))
-	err = c.OVHClient.Delete(path, nil)
-	if apierror, ok := err.(*ovh.APIError); ok && apierror.Code == 404 {
+	err = c.delete(ctx, path, nil)
+	if errors.Is(err, ErrNotFound) {
 		err = nil
 	}
 	return err
@@ -359,17 +452,27 @@ func (c *Client) CloudProjectSSHKeyDelete(projectID, sshkeyID string) (err error
 
 // CloudProjectSSHKeyCreate return the list of users by given a project id
 func (c *Client) CloudProjectSSHKeyCreate(projectID, publicKey, name string) (Sshkey, error) {
+	return c.CloudProjectSSHKeyCreateCtx(context.Background(), projectID, publicKey, name)
+}
+
+// CloudProjectSSHKeyCreateCtx is CloudProjectSSHKeyCreate with a context
+func (c *Client) CloudProjectSSHKeyCreateCtx(ctx context.Context, projectID, publicKey, name string) (Sshkey, error) {
 	path := fmt.Sprintf("/cloud/project/%s/sshkey", url.QueryEscape(projectID))
 	data := map[string]string{
 		"publicKey": publicKey,
 		"name":      name,
 	}
 	sshkey := Sshkey{}
-	return sshkey, c.OVHClient.Post(path, data, &sshkey)
+	return sshkey, c.post(ctx, path, data, &sshkey)
 }
 
 //CloudProjectImagesList returns the list of images by given a project id
 func (c *Client) CloudProjectImagesList(projectID, region string) ([]Image, error) {
+	return c.CloudProjectImagesListCtx(context.Background(), projectID, region)
+}
+
+//CloudProjectImagesListCtx is CloudProjectImagesList with a context
+func (c *Client) CloudProjectImagesListCtx(ctx context.Context, projectID, region string) ([]Image, error) {
 	var path string
 	if region == "" {
 		path = fmt.Sprintf("/cloud/project/%s/image", url.QueryEscape(projectID))
@@ -378,44 +481,27 @@ func (c *Client) CloudProjectImagesList(projectID, region string) ([]Image, erro
 		path = fmt.Sprintf("/cloud/project/%s/image?region=%s", url.QueryEscape(projectID), url.QueryEscape(region))
 	}
 	images := []Image{}
-	return images, c.OVHClient.Get(path, &images)
+	return images, c.get(ctx, path, &images)
 }
 
-//CloudProjectImagesSearch returns the list of images matching terms
+//CloudProjectImagesSearch returns the images and snapshots matching any of terms, once each.
+//See CloudSearchImages for other criteria.
 func (c *Client) CloudProjectImagesSearch(projectID string, region string, terms ...string) ([]Image, error) {
-	images, err := c.CloudProjectImagesList(projectID, region)
-	if err != nil {
-		return nil, err
-	}
-	snapshots, err := c.CloudProjectSnapshotsList(projectID, region)
-	if err != nil {
-		return nil, err
-	}
+	return c.CloudProjectImagesSearchCtx(context.Background(), projectID, region, terms...)
+}
 
-	images = append(images, snapshots...)
-
-	res := []Image{}
-	for i, img := range images {
-		for _, t := range terms {
-			if strings.Contains(img.ID, t) {
-				res = append(res, images[i])
-				continue
-			}
-			if strings.Contains(img.Name, t) {
-				res = append(res, images[i])
-				continue
-			}
-			if strings.Contains(img.OS, t) {
-				res = append(res, images[i])
-				continue
-			}
-		}
-	}
-	return res, nil
+//CloudProjectImagesSearchCtx is CloudProjectImagesSearch with a context
+func (c *Client) CloudProjectImagesSearchCtx(ctx context.Context, projectID string, region string, terms ...string) ([]Image, error) {
+	return c.CloudSearchImagesCtx(ctx, projectID, region, ImageFilter{Terms: terms})
 }
 
 //CloudProjectSnapshotsList returns the list of snapshots by given a project id
 func (c *Client) CloudProjectSnapshotsList(projectID, region string) ([]Image, error) {
+	return c.CloudProjectSnapshotsListCtx(context.Background(), projectID, region)
+}
+
+//CloudProjectSnapshotsListCtx is CloudProjectSnapshotsList with a context
+func (c *Client) CloudProjectSnapshotsListCtx(ctx context.Context, projectID, region string) ([]Image, error) {
 	var path string
 	if region == "" {
 		path = fmt.Sprintf("/cloud/project/%s/snapshot", url.QueryEscape(projectID))
@@ -424,11 +510,16 @@ func (c *Client) CloudProjectSnapshotsList(projectID, region string) ([]Image, e
 		path = fmt.Sprintf("/cloud/project/%s/snapshot?region=%s", url.QueryEscape(projectID), url.QueryEscape(region))
 	}
 	images := []Image{}
-	return images, c.OVHClient.Get(path, &images)
+	return images, c.get(ctx, path, &images)
 }
 
 //CloudProjectFlavorsList returns the list of flavors by given a project id
 func (c *Client) CloudProjectFlavorsList(projectID, region string) ([]Flavor, error) {
+	return c.CloudProjectFlavorsListCtx(context.Background(), projectID, region)
+}
+
+//CloudProjectFlavorsListCtx is CloudProjectFlavorsList with a context
+func (c *Client) CloudProjectFlavorsListCtx(ctx context.Context, projectID, region string) ([]Flavor, error) {
 	var path string
 	if region == "" {
 		path = fmt.Sprintf("/cloud/project/%s/flavor", url.QueryEscape(projectID))
@@ -437,5 +528,5 @@ func (c *Client) CloudProjectFlavorsList(projectID, region string) ([]Flavor, er
 		path = fmt.Sprintf("/cloud/project/%s/flavor?region=%s", url.QueryEscape(projectID), url.QueryEscape(region))
 	}
 	f := []Flavor{}
-	return f, c.OVHClient.Get(path, &f)
+	return f, c.get(ctx, path, &f)
 }
@@ -0,0 +1,121 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// FlavorPlanCodes are the plan codes of a flavor in the catalog, for hourly
+// and monthly billing
+type FlavorPlanCodes struct {
+	Hourly  string `json:"hourly,omitempty"`
+	Monthly string `json:"monthly,omitempty"`
+}
+
+// FlavorFilter defines the criteria of FilterFlavors. Zero values match all flavors.
+type FlavorFilter struct {
+	MinVcpus int
+	// MinRAM is in GB
+	MinRAM int
+	// Type is the type of flavors, such as "ovh.ssd.eg", or the family of
+	// their names, such as "b2"
+	Type string
+	// OS is the OS type of flavors, "linux" or "windows"
+	OS string
+}
+
+// FilterFlavors returns the flavors matching filter
+func FilterFlavors(flavors []Flavor, filter FlavorFilter) []Flavor {
+	res := []Flavor{}
+	for _, f := range flavors {
+		family := strings.SplitN(f.Name, "-", 2)[0]
+		switch {
+		case f.Vcpus < filter.MinVcpus, f.MemoryGB < filter.MinRAM:
+		case filter.Type != "" && f.Type != filter.Type && family != filter.Type:
+		case filter.OS != "" && f.OS != filter.OS:
+		default:
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// Catalog is a go representation of the public catalog of the Cloud offers
+// of an OVH subsidiary
+type Catalog struct {
+	CatalogID int           `json:"catalogId,omitempty"`
+	Locale    CatalogLocale `json:"locale"`
+	Addons    []CatalogPlan `json:"addons,omitempty"`
+}
+
+// CatalogLocale is the subsidiary and the currency of a catalog
+type CatalogLocale struct {
+	CurrencyCode string `json:"currencyCode,omitempty"`
+	Subsidiary   string `json:"subsidiary,omitempty"`
+}
+
+// CatalogPlan is an offer of a catalog, such as the hourly billing of a flavor
+type CatalogPlan struct {
+	PlanCode    string           `json:"planCode,omitempty"`
+	InvoiceName string           `json:"invoiceName,omitempty"`
+	Pricings    []CatalogPricing `json:"pricings,omitempty"`
+}
+
+// CatalogPricing is a price of a plan
+type CatalogPricing struct {
+	// Price is in hundred millionths of the currency
+	Price        int64  `json:"price"`
+	IntervalUnit string `json:"intervalUnit,omitempty"`
+}
+
+// Price returns the price of a plan code, or nil if it is not in the catalog
+func (c *Catalog) Price(planCode string) *OrderPrice {
+	for _, plan := range c.Addons {
+		if plan.PlanCode != planCode || len(plan.Pricings) == 0 {
+			continue
+		}
+		value := float64(plan.Pricings[0].Price) / 1e8
+		return &OrderPrice{
+			CurrencyCode: c.Locale.CurrencyCode,
+			Value:        float32(value),
+			Text:         strconv.FormatFloat(value, 'f', -1, 64) + " " + c.Locale.CurrencyCode,
+		}
+	}
+	return nil
+}
+
+// FlavorPrice is a flavor with its hourly and monthly prices
+type FlavorPrice struct {
+	Flavor
+	HourlyPrice  *OrderPrice `json:"hourlyPrice,omitempty"`
+	MonthlyPrice *OrderPrice `json:"monthlyPrice,omitempty"`
+}
+
+// FlavorPrices returns flavors with their prices in the catalog, when found
+func (c *Catalog) FlavorPrices(flavors []Flavor) []FlavorPrice {
+	prices := make([]FlavorPrice, len(flavors))
+	for i, f := range flavors {
+		prices[i].Flavor = f
+		if f.PlanCodes != nil {
+			prices[i].HourlyPrice = c.Price(f.PlanCodes.Hourly)
+			prices[i].MonthlyPrice = c.Price(f.PlanCodes.Monthly)
+		}
+	}
+	return prices
+}
+
+// CloudCatalog returns the public catalog of the Cloud offers of an OVH
+// subsidiary, such as "FR"
+func (c *Client) CloudCatalog(subsidiary string) (*Catalog, error) {
+	return c.CloudCatalogCtx(context.Background(), subsidiary)
+}
+
+// CloudCatalogCtx is CloudCatalog with a context
+func (c *Client) CloudCatalogCtx(ctx context.Context, subsidiary string) (*Catalog, error) {
+	path := fmt.Sprintf("/order/catalog/public/cloud?ovhSubsidiary=%s", url.QueryEscape(subsidiary))
+	catalog := &Catalog{}
+	return catalog, c.get(ctx, path, catalog)
+}
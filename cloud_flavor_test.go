@@ -0,0 +1,69 @@
+package ovh_test
+
+import (
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+var flavors = []ovh.Flavor{
+	{Name: "s1-2", Type: "ovh.vps-ssd", OS: "linux", Vcpus: 1, MemoryGB: 2},
+	{Name: "b2-7", Type: "ovh.ssd.eg", OS: "linux", Vcpus: 2, MemoryGB: 7, PlanCodes: &ovh.FlavorPlanCodes{Hourly: "b2-7.consumption", Monthly: "b2-7.monthly.postpaid"}},
+	{Name: "b2-7-win", Type: "ovh.ssd.eg", OS: "windows", Vcpus: 2, MemoryGB: 7},
+	{Name: "r2-30", Type: "ovh.ssd.ram", OS: "linux", Vcpus: 2, MemoryGB: 30},
+}
+
+func TestFilterFlavors(t *testing.T) {
+	tests := []struct {
+		filter   ovh.FlavorFilter
+		expected []string
+	}{
+		{ovh.FlavorFilter{}, []string{"s1-2", "b2-7", "b2-7-win", "r2-30"}},
+		{ovh.FlavorFilter{MinVcpus: 2, MinRAM: 8}, []string{"r2-30"}},
+		{ovh.FlavorFilter{Type: "b2", OS: "linux"}, []string{"b2-7"}},
+		{ovh.FlavorFilter{Type: "ovh.ssd.eg"}, []string{"b2-7", "b2-7-win"}},
+		{ovh.FlavorFilter{OS: "windows", MinVcpus: 4}, []string{}},
+	}
+	for _, test := range tests {
+		names := []string{}
+		for _, f := range ovh.FilterFlavors(flavors, test.filter) {
+			names = append(names, f.Name)
+		}
+		if len(names) != len(test.expected) {
+			t.Errorf("expected %v for %+v, got %v", test.expected, test.filter, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != test.expected[i] {
+				t.Errorf("expected %v for %+v, got %v", test.expected, test.filter, names)
+				break
+			}
+		}
+	}
+}
+
+func TestCloudCatalog(t *testing.T) {
+	server, client := newTestServer(t)
+	server.SetCatalog(ovh.Catalog{
+		Locale: ovh.CatalogLocale{CurrencyCode: "EUR", Subsidiary: "FR"},
+		Addons: []ovh.CatalogPlan{
+			{PlanCode: "b2-7.consumption", Pricings: []ovh.CatalogPricing{{Price: 6810000, IntervalUnit: "hour"}}},
+			{PlanCode: "b2-7.monthly.postpaid", Pricings: []ovh.CatalogPricing{{Price: 2500000000, IntervalUnit: "month"}}},
+		},
+	})
+
+	catalog, err := client.CloudCatalog("FR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prices := catalog.FlavorPrices(flavors[:2])
+	if prices[0].Name != "s1-2" || prices[0].HourlyPrice != nil {
+		t.Fatalf("expected no price for s1-2, got %+v", prices[0])
+	}
+	if prices[1].HourlyPrice == nil || prices[1].HourlyPrice.Text != "0.0681 EUR" || prices[1].MonthlyPrice.Text != "25 EUR" {
+		t.Fatalf("unexpected prices of b2-7 %+v", prices[1])
+	}
+
+	_, err = client.CloudCatalog("CA")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,151 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ImageFilter defines the criteria of FilterImages. Zero values match all images.
+type ImageFilter struct {
+	// Terms match the ID, the name or the OS type of images, any of them
+	Terms []string
+	// Visibility is "public" for the images of OVH, or "private" for snapshots
+	Visibility string
+	// OS is the OS type of images, "linux" or "windows"
+	OS string
+	// Disk keeps the images fitting in a disk of Disk GB, such as the disk of a flavor
+	Disk int
+}
+
+// FilterImages returns the images matching filter, once each, in their order
+func FilterImages(images []Image, filter ImageFilter) []Image {
+	res := []Image{}
+	seen := map[string]bool{}
+	for _, img := range images {
+		if seen[img.ID] || !filter.match(img) {
+			continue
+		}
+		seen[img.ID] = true
+		res = append(res, img)
+	}
+	return res
+}
+
+// match tells if img matches f
+func (f ImageFilter) match(img Image) bool {
+	if f.Visibility != "" && img.Visibility != f.Visibility {
+		return false
+	}
+	if f.OS != "" && img.OS != f.OS {
+		return false
+	}
+	if f.Disk > 0 && img.MinDisk > f.Disk {
+		return false
+	}
+	if len(f.Terms) == 0 {
+		return true
+	}
+	for _, t := range f.Terms {
+		if strings.Contains(img.ID, t) || strings.Contains(img.Name, t) || strings.Contains(img.OS, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// LatestImage returns the image of the latest version of a distribution, named
+// such as "Ubuntu 24.04" or "Debian 12". The distribution "Ubuntu LTS" keeps
+// the long term support versions of Ubuntu, released in April of even years.
+// Images with more than a version in their name, such as "Ubuntu 24.04 - Docker",
+// are left out.
+func LatestImage(images []Image, distribution string) (*Image, error) {
+	name, lts := distribution, false
+	if strings.EqualFold(distribution, "Ubuntu LTS") {
+		name, lts = "Ubuntu", true
+	}
+
+	var latest *Image
+	var latestVersion []int
+	for i, img := range images {
+		if !strings.HasPrefix(strings.ToLower(img.Name), strings.ToLower(name)+" ") {
+			continue
+		}
+		version, ok := parseVersion(img.Name[len(name)+1:])
+		if !ok || (lts && !isUbuntuLTS(version)) {
+			continue
+		}
+		if latest == nil || compareVersions(version, latestVersion) > 0 {
+			latest, latestVersion = &images[i], version
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("Image of %s %w", distribution, ErrNotFound)
+	}
+	return latest, nil
+}
+
+// parseVersion parses a dotted version, such as 24.04
+func parseVersion(s string) ([]int, bool) {
+	version := []int{}
+	for _, part := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		version = append(version, n)
+	}
+	return version, true
+}
+
+// isUbuntuLTS tells if version is an Ubuntu LTS version, such as 22.04
+func isUbuntuLTS(version []int) bool {
+	return len(version) >= 2 && version[0]%2 == 0 && version[1] == 4
+}
+
+// compareVersions returns -1, 0 or 1 when a is older than, the same as or newer than b
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := 0, 0
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// CloudSearchImages returns the images and snapshots of region, or of all
+// regions if empty, matching filter
+func (c *Client) CloudSearchImages(projectID, region string, filter ImageFilter) ([]Image, error) {
+	return c.CloudSearchImagesCtx(context.Background(), projectID, region, filter)
+}
+
+// CloudSearchImagesCtx is CloudSearchImages with a context
+func (c *Client) CloudSearchImagesCtx(ctx context.Context, projectID, region string, filter ImageFilter) ([]Image, error) {
+	images := []Image{}
+	if filter.Visibility != "private" {
+		public, err := c.CloudProjectImagesListCtx(ctx, projectID, region)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, public...)
+	}
+	if filter.Visibility != "public" {
+		snapshots, err := c.CloudProjectSnapshotsListCtx(ctx, projectID, region)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, snapshots...)
+	}
+	return FilterImages(images, filter), nil
+}
@@ -0,0 +1,91 @@
+package ovh_test
+
+import (
+	"errors"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudSearchImages(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 24.04", Region: "GRA3", OS: "linux", MinDisk: 10})
+	server.AddImage(project.ID, ovh.Image{Name: "Windows Server 2022", Region: "GRA3", OS: "windows", MinDisk: 50})
+	server.AddSnapshot(project.ID, ovh.Image{Name: "ubuntu-linux-backup", Region: "GRA3", OS: "linux"})
+
+	// an image matching several terms is found once
+	found, err := client.CloudProjectImagesSearch(project.ID, "GRA3", "Ubuntu", "linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 || found[0].Name != "Ubuntu 24.04" || found[1].Name != "ubuntu-linux-backup" {
+		t.Fatalf("unexpected search results %+v", found)
+	}
+
+	tests := []struct {
+		filter   ovh.ImageFilter
+		expected []string
+	}{
+		{ovh.ImageFilter{}, []string{"Ubuntu 24.04", "Windows Server 2022", "ubuntu-linux-backup"}},
+		{ovh.ImageFilter{Visibility: "public"}, []string{"Ubuntu 24.04", "Windows Server 2022"}},
+		{ovh.ImageFilter{Visibility: "private"}, []string{"ubuntu-linux-backup"}},
+		{ovh.ImageFilter{OS: "windows"}, []string{"Windows Server 2022"}},
+		{ovh.ImageFilter{OS: "linux", Disk: 20, Visibility: "public"}, []string{"Ubuntu 24.04"}},
+		{ovh.ImageFilter{Disk: 20, Terms: []string{"Windows"}}, []string{}},
+	}
+	for _, test := range tests {
+		images, err := client.CloudSearchImages(project.ID, "GRA3", test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := []string{}
+		for _, img := range images {
+			names = append(names, img.Name)
+		}
+		if len(names) != len(test.expected) {
+			t.Errorf("expected %v for %+v, got %v", test.expected, test.filter, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != test.expected[i] {
+				t.Errorf("expected %v for %+v, got %v", test.expected, test.filter, names)
+				break
+			}
+		}
+	}
+}
+
+func TestLatestImage(t *testing.T) {
+	images := []ovh.Image{
+		{ID: "u2004", Name: "Ubuntu 20.04"},
+		{ID: "u2404", Name: "Ubuntu 24.04"},
+		{ID: "u2410", Name: "Ubuntu 24.10"},
+		{ID: "u2404d", Name: "Ubuntu 24.04 - Docker"},
+		{ID: "d9", Name: "Debian 9"},
+		{ID: "d12", Name: "Debian 12"},
+		{ID: "d11", Name: "Debian 11"},
+	}
+
+	tests := []struct {
+		distribution, expected string
+	}{
+		{"Ubuntu LTS", "u2404"},
+		{"ubuntu lts", "u2404"},
+		{"Ubuntu", "u2410"},
+		{"Debian", "d12"},
+	}
+	for _, test := range tests {
+		img, err := ovh.LatestImage(images, test.distribution)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if img.ID != test.expected {
+			t.Errorf("expected %s for %s, got %s", test.expected, test.distribution, img.ID)
+		}
+	}
+
+	if _, err := ovh.LatestImage(images, "CentOS"); !errors.Is(err, ovh.ErrNotFound) {
+		t.Fatalf("expected no CentOS image, got %v", err)
+	}
+}
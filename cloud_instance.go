@@ -0,0 +1,278 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+)
+
+// InstanceCreateOpts defines the fields for a VM creation
+type InstanceCreateOpts struct {
+	Name     string `json:"name"`
+	FlavorID string `json:"flavorId"`
+	ImageID  string `json:"imageId,omitempty"`
+	Region   string `json:"region"`
+	SSHKeyID string `json:"sshKeyId,omitempty"`
+	// UserData is a script run on the first boot, such as a cloud-init file
+	UserData string `json:"userData,omitempty"`
+	// Networks are the networks to plug, the public one first. None for the public network only.
+	Networks       []InstanceNetwork `json:"networks,omitempty"`
+	MonthlyBilling bool              `json:"monthlyBilling,omitempty"`
+	// GroupID is the instance group, to spread instances on different hosts
+	GroupID string `json:"groupId,omitempty"`
+	// VolumeID is a volume to boot from, instead of an image
+	VolumeID string `json:"volumeId,omitempty"`
+}
+
+// InstanceNetwork is a network plugged to a VM at its creation
+type InstanceNetwork struct {
+	NetworkID string `json:"networkId"`
+	// IP is a fixed IP on a private network. Empty for an IP given by DHCP.
+	IP string `json:"ip,omitempty"`
+}
+
+// InstanceNameData is the data of the name templates of CloudCreateInstances
+type InstanceNameData struct {
+	// Index of the instance, from 1
+	Index int
+	// Count of instances created
+	Count int
+}
+
+// Types of RebootReq
+const (
+	RebootSoft = "soft"
+	RebootHard = "hard"
+)
+
+// RescueReq defines the fields to enter or exit the rescue mode of a VM
+type RescueReq struct {
+	ImageID string `json:"imageId,omitempty"`
+	Rescue  bool   `json:"rescue"`
+}
+
+// RescueAdminPassword is the password of a VM in rescue mode
+type RescueAdminPassword struct {
+	AdminPassword *string `json:"adminPassword"`
+}
+
+// ReinstallReq defines the fields for a VM reinstall
+type ReinstallReq struct {
+	ImageID string `json:"imageId"`
+}
+
+// ResizeReq defines the fields for a VM resize
+type ResizeReq struct {
+	FlavorID string `json:"flavorId"`
+}
+
+// CloudCreateInstanceWithOpts starts a new public cloud instance and returns resulting object
+func (c *Client) CloudCreateInstanceWithOpts(projectID string, opts InstanceCreateOpts) (*Instance, error) {
+	return c.CloudCreateInstanceWithOptsCtx(context.Background(), projectID, opts)
+}
+
+// CloudCreateInstanceWithOptsCtx is CloudCreateInstanceWithOpts with a context
+func (c *Client) CloudCreateInstanceWithOptsCtx(ctx context.Context, projectID string, opts InstanceCreateOpts) (instance *Instance, err error) {
+	path := fmt.Sprintf("/cloud/project/%s/instance", url.QueryEscape(projectID))
+	err = c.post(ctx, path, opts, &instance)
+	return instance, err
+}
+
+// CloudCreateInstances starts count instances with the settings of opts, and
+// returns them. Their name is a template executed with InstanceNameData, such as
+// "web-{{.Index}}"; "-<index>" is appended to names without template.
+//
+// Instances are created one after the other. On failure, the instances already
+// created are returned along with the error.
+func (c *Client) CloudCreateInstances(projectID string, opts InstanceCreateOpts, count int) ([]Instance, error) {
+	return c.CloudCreateInstancesCtx(context.Background(), projectID, opts, count)
+}
+
+// CloudCreateInstancesCtx is CloudCreateInstances with a context
+func (c *Client) CloudCreateInstancesCtx(ctx context.Context, projectID string, opts InstanceCreateOpts, count int) ([]Instance, error) {
+	names, err := InstanceNames(opts.Name, count)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []Instance{}
+	for _, name := range names {
+		o := opts
+		o.Name = name
+		instance, err := c.CloudCreateInstanceWithOptsCtx(ctx, projectID, o)
+		if err != nil {
+			return instances, fmt.Errorf("Instance %s: %w", name, err)
+		}
+		instances = append(instances, *instance)
+	}
+	return instances, nil
+}
+
+// InstanceNames returns the names of count instances, executing the template
+// name with InstanceNameData. "-<index>" is appended to a name without
+// template when count is more than 1.
+func InstanceNames(name string, count int) ([]string, error) {
+	if count > 1 && !strings.Contains(name, "{{") {
+		name += "-{{.Index}}"
+	}
+	t, err := template.New("name").Parse(name)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid instance name %q: %s", name, err)
+	}
+
+	names := make([]string, count)
+	for i := range names {
+		var b bytes.Buffer
+		if err = t.Execute(&b, InstanceNameData{Index: i + 1, Count: count}); err != nil {
+			return nil, fmt.Errorf("Invalid instance name %q: %s", name, err)
+		}
+		names[i] = b.String()
+	}
+	return names, nil
+}
+
+// instanceActionPath returns the path of an action on an instance
+func instanceActionPath(projectID, instanceID, action string) string {
+	return fmt.Sprintf("/cloud/project/%s/instance/%s/%s", url.QueryEscape(projectID), url.QueryEscape(instanceID), action)
+}
+
+// CloudRebootInstance reboots a VM, RebootSoft or RebootHard
+func (c *Client) CloudRebootInstance(projectID, instanceID, rebootType string) error {
+	return c.CloudRebootInstanceCtx(context.Background(), projectID, instanceID, rebootType)
+}
+
+// CloudRebootInstanceCtx is CloudRebootInstance with a context
+func (c *Client) CloudRebootInstanceCtx(ctx context.Context, projectID, instanceID, rebootType string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "reboot"), RebootReq{Type: rebootType}, nil)
+}
+
+// CloudStartInstance starts a stopped VM
+func (c *Client) CloudStartInstance(projectID, instanceID string) error {
+	return c.CloudStartInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudStartInstanceCtx is CloudStartInstance with a context
+func (c *Client) CloudStartInstanceCtx(ctx context.Context, projectID, instanceID string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "start"), nil, nil)
+}
+
+// CloudStopInstance stops a VM. A stopped VM is still billed, see CloudShelveInstance.
+func (c *Client) CloudStopInstance(projectID, instanceID string) error {
+	return c.CloudStopInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudStopInstanceCtx is CloudStopInstance with a context
+func (c *Client) CloudStopInstanceCtx(ctx context.Context, projectID, instanceID string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "stop"), nil, nil)
+}
+
+// CloudShelveInstance shelves a VM: its disk is saved as a snapshot and its
+// resources are released
+func (c *Client) CloudShelveInstance(projectID, instanceID string) error {
+	return c.CloudShelveInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudShelveInstanceCtx is CloudShelveInstance with a context
+func (c *Client) CloudShelveInstanceCtx(ctx context.Context, projectID, instanceID string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "shelve"), nil, nil)
+}
+
+// CloudUnshelveInstance restores a shelved VM
+func (c *Client) CloudUnshelveInstance(projectID, instanceID string) error {
+	return c.CloudUnshelveInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudUnshelveInstanceCtx is CloudUnshelveInstance with a context
+func (c *Client) CloudUnshelveInstanceCtx(ctx context.Context, projectID, instanceID string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "unshelve"), nil, nil)
+}
+
+// CloudRescueInstance reboots a VM in rescue mode, on imageID or on the
+// default rescue image if empty, and returns the password of the rescue system
+func (c *Client) CloudRescueInstance(projectID, instanceID, imageID string) (string, error) {
+	return c.CloudRescueInstanceCtx(context.Background(), projectID, instanceID, imageID)
+}
+
+// CloudRescueInstanceCtx is CloudRescueInstance with a context
+func (c *Client) CloudRescueInstanceCtx(ctx context.Context, projectID, instanceID, imageID string) (string, error) {
+	password := &RescueAdminPassword{}
+	err := c.post(ctx, instanceActionPath(projectID, instanceID, "rescueMode"), RescueReq{ImageID: imageID, Rescue: true}, password)
+	if err != nil || password.AdminPassword == nil {
+		return "", err
+	}
+	return *password.AdminPassword, nil
+}
+
+// CloudUnrescueInstance reboots a VM in rescue mode on its own disk
+func (c *Client) CloudUnrescueInstance(projectID, instanceID string) error {
+	return c.CloudUnrescueInstanceCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudUnrescueInstanceCtx is CloudUnrescueInstance with a context
+func (c *Client) CloudUnrescueInstanceCtx(ctx context.Context, projectID, instanceID string) error {
+	return c.post(ctx, instanceActionPath(projectID, instanceID, "rescueMode"), RescueReq{Rescue: false}, nil)
+}
+
+// CloudReinstallInstance reinstalls a VM with an image. The data of the VM are lost.
+func (c *Client) CloudReinstallInstance(projectID, instanceID, imageID string) (*Instance, error) {
+	return c.CloudReinstallInstanceCtx(context.Background(), projectID, instanceID, imageID)
+}
+
+// CloudReinstallInstanceCtx is CloudReinstallInstance with a context
+func (c *Client) CloudReinstallInstanceCtx(ctx context.Context, projectID, instanceID, imageID string) (*Instance, error) {
+	instance := &Instance{}
+	err := c.post(ctx, instanceActionPath(projectID, instanceID, "reinstall"), ReinstallReq{ImageID: imageID}, instance)
+	return instance, err
+}
+
+// CloudResizeInstance moves a VM to another flavor, with at least as much disk
+func (c *Client) CloudResizeInstance(projectID, instanceID, flavorID string) (*Instance, error) {
+	return c.CloudResizeInstanceCtx(context.Background(), projectID, instanceID, flavorID)
+}
+
+// CloudResizeInstanceCtx is CloudResizeInstance with a context
+func (c *Client) CloudResizeInstanceCtx(ctx context.Context, projectID, instanceID, flavorID string) (*Instance, error) {
+	instance := &Instance{}
+	err := c.post(ctx, instanceActionPath(projectID, instanceID, "resize"), ResizeReq{FlavorID: flavorID}, instance)
+	return instance, err
+}
+
+// InstanceVNC is the VNC console of a VM
+type InstanceVNC struct {
+	Type string `json:"type,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// PublicIP returns the public IPv4 of a VM, or its public IPv6 if it has no
+// public IPv4. With ipv6 set, it returns its public IPv6 only. It is empty
+// until the VM is built.
+func (i *Instance) PublicIP(ipv6 bool) string {
+	found := ""
+	for _, ip := range i.IPAddresses {
+		if ip.Type != "public" {
+			continue
+		}
+		if (ip.Version == 6) == ipv6 {
+			return ip.IP
+		}
+		if found == "" && !ipv6 {
+			found = ip.IP
+		}
+	}
+	return found
+}
+
+// CloudInstanceVNC returns the VNC console of a VM. Its URL is only valid for
+// a short time.
+func (c *Client) CloudInstanceVNC(projectID, instanceID string) (*InstanceVNC, error) {
+	return c.CloudInstanceVNCCtx(context.Background(), projectID, instanceID)
+}
+
+// CloudInstanceVNCCtx is CloudInstanceVNC with a context
+func (c *Client) CloudInstanceVNCCtx(ctx context.Context, projectID, instanceID string) (*InstanceVNC, error) {
+	vnc := &InstanceVNC{}
+	return vnc, c.post(ctx, instanceActionPath(projectID, instanceID, "vnc"), nil, vnc)
+}
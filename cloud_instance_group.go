@@ -0,0 +1,122 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Policies of instance groups
+const (
+	// InstanceGroupAntiAffinity places the instances of a group on different hosts
+	InstanceGroupAntiAffinity = "anti-affinity"
+	// InstanceGroupAffinity places the instances of a group on the same host
+	InstanceGroupAffinity = "affinity"
+)
+
+// InstanceGroup is a go representation of a group of instances, placed by
+// the policy of the group
+type InstanceGroup struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Region string `json:"region,omitempty"`
+	// Type is the policy of the group, InstanceGroupAntiAffinity or InstanceGroupAffinity
+	Type        string   `json:"type,omitempty"`
+	InstanceIDs []string `json:"instance_ids,omitempty"`
+}
+
+// InstanceGroupCreateOpts defines the fields for an instance group creation
+type InstanceGroupCreateOpts struct {
+	Name   string `json:"name"`
+	Region string `json:"region"`
+	// Policy is InstanceGroupAntiAffinity or InstanceGroupAffinity
+	Policy string `json:"policy"`
+}
+
+// instanceGroupPath returns the path of an instance group
+func instanceGroupPath(projectID, groupID string) string {
+	return fmt.Sprintf("/cloud/project/%s/instance/group/%s", url.QueryEscape(projectID), url.QueryEscape(groupID))
+}
+
+// CloudListInstanceGroups returns the instance groups of region, or of all regions if empty
+func (c *Client) CloudListInstanceGroups(projectID, region string) ([]InstanceGroup, error) {
+	return c.CloudListInstanceGroupsCtx(context.Background(), projectID, region)
+}
+
+// CloudListInstanceGroupsCtx is CloudListInstanceGroups with a context
+func (c *Client) CloudListInstanceGroupsCtx(ctx context.Context, projectID, region string) ([]InstanceGroup, error) {
+	path := fmt.Sprintf("/cloud/project/%s/instance/group", url.QueryEscape(projectID))
+	if region != "" {
+		path += "?region=" + url.QueryEscape(region)
+	}
+	groups := []InstanceGroup{}
+	return groups, c.get(ctx, path, &groups)
+}
+
+// CloudCreateInstanceGroup creates an instance group and returns it. Instances
+// join the group at their creation, see InstanceCreateOpts.GroupID.
+func (c *Client) CloudCreateInstanceGroup(projectID string, opts InstanceGroupCreateOpts) (*InstanceGroup, error) {
+	return c.CloudCreateInstanceGroupCtx(context.Background(), projectID, opts)
+}
+
+// CloudCreateInstanceGroupCtx is CloudCreateInstanceGroup with a context
+func (c *Client) CloudCreateInstanceGroupCtx(ctx context.Context, projectID string, opts InstanceGroupCreateOpts) (*InstanceGroup, error) {
+	path := fmt.Sprintf("/cloud/project/%s/instance/group", url.QueryEscape(projectID))
+	group := &InstanceGroup{}
+	return group, c.post(ctx, path, opts, group)
+}
+
+// CloudInfoInstanceGroup returns an instance group
+func (c *Client) CloudInfoInstanceGroup(projectID, groupID string) (*InstanceGroup, error) {
+	return c.CloudInfoInstanceGroupCtx(context.Background(), projectID, groupID)
+}
+
+// CloudInfoInstanceGroupCtx is CloudInfoInstanceGroup with a context
+func (c *Client) CloudInfoInstanceGroupCtx(ctx context.Context, projectID, groupID string) (*InstanceGroup, error) {
+	group := &InstanceGroup{}
+	return group, c.get(ctx, instanceGroupPath(projectID, groupID), group)
+}
+
+// CloudDeleteInstanceGroup deletes an instance group. Its instances are kept.
+func (c *Client) CloudDeleteInstanceGroup(projectID, groupID string) error {
+	return c.CloudDeleteInstanceGroupCtx(context.Background(), projectID, groupID)
+}
+
+// CloudDeleteInstanceGroupCtx is CloudDeleteInstanceGroup with a context
+func (c *Client) CloudDeleteInstanceGroupCtx(ctx context.Context, projectID, groupID string) error {
+	err := c.delete(ctx, instanceGroupPath(projectID, groupID), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// CloudListInstanceGroupMembers returns the instances of an instance group
+func (c *Client) CloudListInstanceGroupMembers(projectID, groupID string) ([]Instance, error) {
+	return c.CloudListInstanceGroupMembersCtx(context.Background(), projectID, groupID)
+}
+
+// CloudListInstanceGroupMembersCtx is CloudListInstanceGroupMembers with a context
+func (c *Client) CloudListInstanceGroupMembersCtx(ctx context.Context, projectID, groupID string) ([]Instance, error) {
+	group, err := c.CloudInfoInstanceGroupCtx(ctx, projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := c.CloudListInstanceCtx(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := map[string]bool{}
+	for _, id := range group.InstanceIDs {
+		members[id] = true
+	}
+	found := []Instance{}
+	for _, instance := range instances {
+		if members[instance.ID] {
+			found = append(found, instance)
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,70 @@
+package ovh_test
+
+import (
+	"errors"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudInstanceGroups(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3"})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3"})
+	server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+
+	group, err := client.CloudCreateInstanceGroup(project.ID, ovh.InstanceGroupCreateOpts{Name: "etcd", Region: "GRA3", Policy: ovh.InstanceGroupAntiAffinity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group.ID == "" || group.Type != ovh.InstanceGroupAntiAffinity {
+		t.Fatalf("unexpected group %+v", group)
+	}
+	if _, err = client.CloudCreateInstanceGroup(project.ID, ovh.InstanceGroupCreateOpts{Name: "etcd", Region: "GRA3", Policy: "nearby"}); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid policy, got %v", err)
+	}
+
+	opts := ovh.InstanceCreateOpts{Name: "etcd-{{.Index}}", FlavorID: flavor.ID, ImageID: image.ID, Region: "GRA3", GroupID: group.ID}
+	instances, err := client.CloudCreateInstances(project.ID, opts, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, err := client.CloudListInstanceGroupMembers(project.ID, group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 3 || members[0].Name != "etcd-1" {
+		t.Fatalf("expected the 3 etcd instances, got %+v", members)
+	}
+
+	if err = client.CloudDeleteInstance(project.ID, instances[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if group, err = client.CloudInfoInstanceGroup(project.ID, group.ID); err != nil || len(group.InstanceIDs) != 2 {
+		t.Fatalf("expected a deleted instance to leave its group, got %+v, %v", group, err)
+	}
+
+	opts.Region = "SBG5"
+	if _, err = client.CloudCreateInstanceWithOpts(project.ID, opts); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an error creating an instance out of the region of its group, got %v", err)
+	}
+
+	if groups, err := client.CloudListInstanceGroups(project.ID, "SBG5"); err != nil || len(groups) != 0 {
+		t.Fatalf("expected no group in SBG5, got %+v, %v", groups, err)
+	}
+	groups, err := client.CloudListInstanceGroups(project.ID, "")
+	if err != nil || len(groups) != 1 || groups[0].Name != "etcd" {
+		t.Fatalf("expected the etcd group, got %+v, %v", groups, err)
+	}
+
+	if err = client.CloudDeleteInstanceGroup(project.ID, group.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoInstanceGroup(project.ID, group.ID)
+	checkNotFound(t, err)
+	if err = client.CloudDeleteInstanceGroup(project.ID, group.ID); err != nil {
+		t.Fatalf("expected deleting a missing group to succeed, got %v", err)
+	}
+}
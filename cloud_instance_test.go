@@ -0,0 +1,217 @@
+package ovh_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// checkStatus fails the test if the instance is not in status
+func checkStatus(t *testing.T, client *ovh.Client, projectID, instanceID, status string) {
+	t.Helper()
+	instance, err := client.CloudInfoInstance(projectID, instanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.Status != status {
+		t.Fatalf("expected status %s, got %s", status, instance.Status)
+	}
+}
+
+func TestCloudInstanceLifecycle(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	small := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3", DiskSpaceGB: 10})
+	large := server.AddFlavor(project.ID, ovh.Flavor{Name: "b2-7", Region: "GRA3", DiskSpaceGB: 50})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Debian 9", Region: "GRA3"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3", Flavor: &large})
+
+	if err := client.CloudRebootInstance(project.ID, instance.ID, ovh.RebootHard); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "HARD_REBOOT")
+	checkStatus(t, client, project.ID, instance.ID, "ACTIVE")
+	if err := client.CloudRebootInstance(project.ID, instance.ID, "gentle"); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid reboot type, got %v", err)
+	}
+
+	if err := client.CloudStopInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "SHUTOFF")
+	if err := client.CloudStopInstance(project.ID, instance.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict stopping a stopped instance, got %v", err)
+	}
+	if err := client.CloudStartInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "ACTIVE")
+
+	if err := client.CloudShelveInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "SHELVED_OFFLOADED")
+	if err := client.CloudUnshelveInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "ACTIVE")
+
+	password, err := client.CloudRescueInstance(project.ID, instance.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password == "" {
+		t.Fatal("expected a rescue password")
+	}
+	checkStatus(t, client, project.ID, instance.ID, "RESCUE")
+	if err = client.CloudUnrescueInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, client, project.ID, instance.ID, "ACTIVE")
+
+	reinstalled, err := client.CloudReinstallInstance(project.ID, instance.ID, image.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reinstalled.Image == nil || reinstalled.Image.ID != image.ID {
+		t.Fatalf("expected the instance to be reinstalled with %s, got %+v", image.ID, reinstalled.Image)
+	}
+
+	if _, err = client.CloudResizeInstance(project.ID, instance.ID, small.ID); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an error resizing to a smaller disk, got %v", err)
+	}
+	server.AddFlavor(project.ID, ovh.Flavor{ID: "xl", Name: "b2-15", Region: "GRA3", DiskSpaceGB: 100})
+	resized, err := client.CloudResizeInstance(project.ID, instance.ID, "xl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resized.Flavor == nil || resized.Flavor.Name != "b2-15" {
+		t.Fatalf("expected the instance to be resized, got %+v", resized.Flavor)
+	}
+
+	err = client.CloudStartInstance(project.ID, "missing")
+	checkNotFound(t, err)
+}
+
+func TestInstanceNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+		want  string
+	}{
+		{"web", 1, "web"},
+		{"web", 3, "web-1 web-2 web-3"},
+		{"web-{{.Index}}", 2, "web-1 web-2"},
+		{"db-{{.Index}}-of-{{.Count}}", 2, "db-1-of-2 db-2-of-2"},
+		{`web-{{printf "%02d" .Index}}`, 2, "web-01 web-02"},
+	}
+	for _, test := range tests {
+		names, err := ovh.InstanceNames(test.name, test.count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(names, " "); got != test.want {
+			t.Errorf("%s x%d: expected %s, got %s", test.name, test.count, test.want, got)
+		}
+	}
+
+	if _, err := ovh.InstanceNames("web-{{.Index", 2); err == nil {
+		t.Fatal("expected an error on an invalid template")
+	}
+}
+
+func TestCloudCreateInstances(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3"})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3"})
+	public := server.AddPublicNetwork(project.ID, ovh.Network{Name: "Ext-Net"})
+	private := server.AddPrivateNetwork(project.ID, ovh.Network{Name: "backend", VlanID: 42})
+
+	opts := ovh.InstanceCreateOpts{
+		Name:           "web-{{.Index}}",
+		FlavorID:       flavor.ID,
+		ImageID:        image.ID,
+		Region:         "GRA3",
+		UserData:       "#cloud-config\npackages: [nginx]\n",
+		Networks:       []ovh.InstanceNetwork{{NetworkID: public.ID}, {NetworkID: private.ID, IP: "10.0.0.10"}},
+		MonthlyBilling: true,
+	}
+	instances, err := client.CloudCreateInstances(project.ID, opts, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 3 || instances[0].Name != "web-1" || instances[2].Name != "web-3" {
+		t.Fatalf("unexpected instances %+v", instances)
+	}
+	if instances[0].MonthlyBilling == nil {
+		t.Fatal("expected the instances to be billed monthly")
+	}
+	if data := server.InstanceUserData(project.ID, instances[1].ID); data != opts.UserData {
+		t.Fatalf("expected the user data to be sent, got %q", data)
+	}
+
+	got, err := client.CloudInfoInstance(project.ID, instances[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.IPAddresses) != 2 || got.IPAddresses[1].IP != "10.0.0.10" || got.IPAddresses[1].NetworkID != private.ID {
+		t.Fatalf("expected a public and a private IP, got %+v", got.IPAddresses)
+	}
+
+	opts.Name = "db"
+	opts.Networks = []ovh.InstanceNetwork{{NetworkID: "missing"}}
+	instances, err = client.CloudCreateInstances(project.ID, opts, 2)
+	checkNotFound(t, err)
+	if len(instances) != 0 {
+		t.Fatalf("expected no instance created, got %+v", instances)
+	}
+}
+
+func TestInstancePublicIP(t *testing.T) {
+	instance := ovh.Instance{IPAddresses: []ovh.IP{
+		{IP: "10.0.0.2", Type: "private", Version: 4},
+		{IP: "2001:db8::1", Type: "public", Version: 6},
+		{IP: "192.0.2.1", Type: "public", Version: 4},
+	}}
+	if ip := instance.PublicIP(false); ip != "192.0.2.1" {
+		t.Errorf("expected the public IPv4, got %q", ip)
+	}
+	if ip := instance.PublicIP(true); ip != "2001:db8::1" {
+		t.Errorf("expected the public IPv6, got %q", ip)
+	}
+
+	instance.IPAddresses = instance.IPAddresses[:2]
+	if ip := instance.PublicIP(false); ip != "2001:db8::1" {
+		t.Errorf("expected the public IPv6 without public IPv4, got %q", ip)
+	}
+	instance.IPAddresses = []ovh.IP{{IP: "192.0.2.1", Type: "public", Version: 4}}
+	if ip := instance.PublicIP(true); ip != "" {
+		t.Errorf("expected no IP without public IPv6, got %q", ip)
+	}
+	instance.IPAddresses = nil
+	if ip := instance.PublicIP(false); ip != "" {
+		t.Errorf("expected no IP before the instance is built, got %q", ip)
+	}
+}
+
+func TestCloudInstanceVNC(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+
+	vnc, err := client.CloudInstanceVNC(project.ID, instance.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vnc.Type != "novnc" || !strings.HasPrefix(vnc.URL, server.URL) {
+		t.Fatalf("unexpected console %+v", vnc)
+	}
+
+	server.SetInstanceStatus(project.ID, instance.ID, "SHUTOFF")
+	if _, err = client.CloudInstanceVNC(project.ID, instance.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict for a stopped instance, got %v", err)
+	}
+}
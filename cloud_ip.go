@@ -0,0 +1,74 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// FailoverIP is a go representation of a Cloud failover IP, routed to an
+// instance of the project
+type FailoverIP struct {
+	ID            string `json:"id,omitempty"`
+	IP            string `json:"ip,omitempty"`
+	Block         string `json:"block,omitempty"`
+	ContinentCode string `json:"continentCode,omitempty"`
+	Geoloc        string `json:"geoloc,omitempty"`
+	// RoutedTo is the ID of the instance the IP is routed to
+	RoutedTo string `json:"routedTo,omitempty"`
+	// Status is "ok", or "operationPending" while the IP moves
+	Status string `json:"status,omitempty"`
+	// Progress of the operation pending, in percent
+	Progress int    `json:"progress,omitempty"`
+	SubType  string `json:"subType,omitempty"`
+}
+
+// FailoverIPAttachReq defines the fields to route a failover IP to an instance
+type FailoverIPAttachReq struct {
+	InstanceID string `json:"instanceId"`
+}
+
+// failoverIPPath returns the path of a failover IP, or of one of its actions if action is set
+func failoverIPPath(projectID, ipID, action string) string {
+	path := fmt.Sprintf("/cloud/project/%s/ip/failover/%s", url.QueryEscape(projectID), url.QueryEscape(ipID))
+	if action != "" {
+		path += "/" + action
+	}
+	return path
+}
+
+// CloudListFailoverIPs returns the failover IPs of a project
+func (c *Client) CloudListFailoverIPs(projectID string) ([]FailoverIP, error) {
+	return c.CloudListFailoverIPsCtx(context.Background(), projectID)
+}
+
+// CloudListFailoverIPsCtx is CloudListFailoverIPs with a context
+func (c *Client) CloudListFailoverIPsCtx(ctx context.Context, projectID string) ([]FailoverIP, error) {
+	path := fmt.Sprintf("/cloud/project/%s/ip/failover", url.QueryEscape(projectID))
+	ips := []FailoverIP{}
+	return ips, c.get(ctx, path, &ips)
+}
+
+// CloudInfoFailoverIP returns a failover IP
+func (c *Client) CloudInfoFailoverIP(projectID, ipID string) (*FailoverIP, error) {
+	return c.CloudInfoFailoverIPCtx(context.Background(), projectID, ipID)
+}
+
+// CloudInfoFailoverIPCtx is CloudInfoFailoverIP with a context
+func (c *Client) CloudInfoFailoverIPCtx(ctx context.Context, projectID, ipID string) (*FailoverIP, error) {
+	ip := &FailoverIP{}
+	return ip, c.get(ctx, failoverIPPath(projectID, ipID, ""), ip)
+}
+
+// CloudAttachFailoverIP routes a failover IP to an instance, and returns it.
+// The IP is routed once "ok", see WaitFailoverIPRouted. The system of the
+// instance must be configured with the IP.
+func (c *Client) CloudAttachFailoverIP(projectID, ipID, instanceID string) (*FailoverIP, error) {
+	return c.CloudAttachFailoverIPCtx(context.Background(), projectID, ipID, instanceID)
+}
+
+// CloudAttachFailoverIPCtx is CloudAttachFailoverIP with a context
+func (c *Client) CloudAttachFailoverIPCtx(ctx context.Context, projectID, ipID, instanceID string) (*FailoverIP, error) {
+	ip := &FailoverIP{}
+	return ip, c.post(ctx, failoverIPPath(projectID, ipID, "attach"), FailoverIPAttachReq{InstanceID: instanceID}, ip)
+}
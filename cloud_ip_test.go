@@ -0,0 +1,58 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudFailoverIPs(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	active := server.AddInstance(project.ID, ovh.Instance{Name: "lb-1", Region: "GRA3"})
+	passive := server.AddInstance(project.ID, ovh.Instance{Name: "lb-2", Region: "GRA3"})
+	ip := server.AddFailoverIP(project.ID, ovh.FailoverIP{IP: "203.0.113.10", RoutedTo: active.ID})
+
+	ips, err := client.CloudListFailoverIPs(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ips) != 1 || ips[0].IP != "203.0.113.10" || ips[0].Block != "203.0.113.10/32" {
+		t.Fatalf("unexpected failover IPs %+v", ips)
+	}
+
+	// fail over to the passive instance
+	attached, err := client.CloudAttachFailoverIP(project.ID, ip.ID, passive.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attached.Status != "operationPending" {
+		t.Fatalf("expected a pending operation, got %+v", attached)
+	}
+	if _, err = client.CloudAttachFailoverIP(project.ID, ip.ID, active.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict while an operation is pending, got %v", err)
+	}
+
+	statuses := []string{}
+	routed, err := client.WaitFailoverIPRouted(context.Background(), project.ID, ip.ID, passive.ID,
+		ovh.WithWaitInterval(time.Millisecond),
+		ovh.WithWaitProgress(func(status string, elapsed time.Duration) { statuses = append(statuses, status) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if routed.RoutedTo != passive.ID || routed.Status != "ok" {
+		t.Fatalf("expected the IP to be routed to %s, got %+v", passive.ID, routed)
+	}
+	if len(statuses) != 2 || statuses[0] != "operationPending 50%" || statuses[1] != "ok" {
+		t.Fatalf("unexpected statuses %v", statuses)
+	}
+
+	_, err = client.CloudAttachFailoverIP(project.ID, ip.ID, "unknown")
+	checkNotFound(t, err)
+	_, err = client.CloudInfoFailoverIP(project.ID, "unknown")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,149 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// NetworkPrivateCreateOpts defines the fields for a private network creation
+type NetworkPrivateCreateOpts struct {
+	Name string `json:"name"`
+	// VlanID is the VLAN of the network in the vRack, 0 for the untagged VLAN
+	VlanID int `json:"vlanId"`
+	// Regions of the network, all the regions of the project if empty
+	Regions []string `json:"regions,omitempty"`
+}
+
+// NetworkRegionReq defines the fields to add a region to a private network
+type NetworkRegionReq struct {
+	Region string `json:"region"`
+}
+
+// Subnet is a go representation of a subnet of a Cloud private network
+type Subnet struct {
+	ID        string   `json:"id,omitempty"`
+	CIDR      string   `json:"cidr,omitempty"`
+	GatewayIP string   `json:"gatewayIp,omitempty"`
+	IPPools   []IPPool `json:"ipPools,omitempty"`
+}
+
+// IPPool is a range of IPs of a subnet in a region
+type IPPool struct {
+	Region  string `json:"region,omitempty"`
+	Network string `json:"network,omitempty"`
+	Start   string `json:"start,omitempty"`
+	End     string `json:"end,omitempty"`
+	DHCP    bool   `json:"dhcp"`
+}
+
+// SubnetCreateOpts defines the fields for a subnet creation
+type SubnetCreateOpts struct {
+	Region string `json:"region"`
+	// Network is the CIDR of the subnet, such as 10.0.0.0/24
+	Network string `json:"network"`
+	// Start and End are the range of IPs given to instances
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// DHCP gives the IPs of the range to instances
+	DHCP bool `json:"dhcp"`
+	// NoGateway creates the subnet without gateway
+	NoGateway bool `json:"noGateway"`
+}
+
+// networkPrivatePath returns the path of a private network, or of one of its
+// sub-resources if sub is set
+func networkPrivatePath(projectID, networkID, sub string) string {
+	path := fmt.Sprintf("/cloud/project/%s/network/private/%s", url.QueryEscape(projectID), url.QueryEscape(networkID))
+	if sub != "" {
+		path += "/" + sub
+	}
+	return path
+}
+
+// CloudCreateNetworkPrivateWithOpts creates a private network in the vRack of
+// the project, and returns it. The network is usable in a region once ACTIVE
+// there, see WaitNetworkPrivateActive.
+func (c *Client) CloudCreateNetworkPrivateWithOpts(projectID string, opts NetworkPrivateCreateOpts) (*Network, error) {
+	return c.CloudCreateNetworkPrivateWithOptsCtx(context.Background(), projectID, opts)
+}
+
+// CloudCreateNetworkPrivateWithOptsCtx is CloudCreateNetworkPrivateWithOpts with a context
+func (c *Client) CloudCreateNetworkPrivateWithOptsCtx(ctx context.Context, projectID string, opts NetworkPrivateCreateOpts) (*Network, error) {
+	path := fmt.Sprintf("/cloud/project/%s/network/private", url.QueryEscape(projectID))
+	network := &Network{}
+	return network, c.post(ctx, path, opts, network)
+}
+
+// CloudGetNetworkPrivate returns a private network
+func (c *Client) CloudGetNetworkPrivate(projectID, networkID string) (*Network, error) {
+	return c.CloudGetNetworkPrivateCtx(context.Background(), projectID, networkID)
+}
+
+// CloudGetNetworkPrivateCtx is CloudGetNetworkPrivate with a context
+func (c *Client) CloudGetNetworkPrivateCtx(ctx context.Context, projectID, networkID string) (*Network, error) {
+	network := &Network{}
+	return network, c.get(ctx, networkPrivatePath(projectID, networkID, ""), network)
+}
+
+// CloudAddNetworkPrivateRegion adds a region to a private network, and returns the network
+func (c *Client) CloudAddNetworkPrivateRegion(projectID, networkID, region string) (*Network, error) {
+	return c.CloudAddNetworkPrivateRegionCtx(context.Background(), projectID, networkID, region)
+}
+
+// CloudAddNetworkPrivateRegionCtx is CloudAddNetworkPrivateRegion with a context
+func (c *Client) CloudAddNetworkPrivateRegionCtx(ctx context.Context, projectID, networkID, region string) (*Network, error) {
+	network := &Network{}
+	return network, c.post(ctx, networkPrivatePath(projectID, networkID, "region"), NetworkRegionReq{Region: region}, network)
+}
+
+// CloudDeleteNetworkPrivate deletes a private network and its subnets
+func (c *Client) CloudDeleteNetworkPrivate(projectID, networkID string) error {
+	return c.CloudDeleteNetworkPrivateCtx(context.Background(), projectID, networkID)
+}
+
+// CloudDeleteNetworkPrivateCtx is CloudDeleteNetworkPrivate with a context
+func (c *Client) CloudDeleteNetworkPrivateCtx(ctx context.Context, projectID, networkID string) error {
+	err := c.delete(ctx, networkPrivatePath(projectID, networkID, ""), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// CloudListSubnets returns the subnets of a private network
+func (c *Client) CloudListSubnets(projectID, networkID string) ([]Subnet, error) {
+	return c.CloudListSubnetsCtx(context.Background(), projectID, networkID)
+}
+
+// CloudListSubnetsCtx is CloudListSubnets with a context
+func (c *Client) CloudListSubnetsCtx(ctx context.Context, projectID, networkID string) ([]Subnet, error) {
+	subnets := []Subnet{}
+	return subnets, c.get(ctx, networkPrivatePath(projectID, networkID, "subnet"), &subnets)
+}
+
+// CloudCreateSubnet creates a subnet of a private network in a region, and returns it
+func (c *Client) CloudCreateSubnet(projectID, networkID string, opts SubnetCreateOpts) (*Subnet, error) {
+	return c.CloudCreateSubnetCtx(context.Background(), projectID, networkID, opts)
+}
+
+// CloudCreateSubnetCtx is CloudCreateSubnet with a context
+func (c *Client) CloudCreateSubnetCtx(ctx context.Context, projectID, networkID string, opts SubnetCreateOpts) (*Subnet, error) {
+	subnet := &Subnet{}
+	return subnet, c.post(ctx, networkPrivatePath(projectID, networkID, "subnet"), opts, subnet)
+}
+
+// CloudDeleteSubnet deletes a subnet of a private network
+func (c *Client) CloudDeleteSubnet(projectID, networkID, subnetID string) error {
+	return c.CloudDeleteSubnetCtx(context.Background(), projectID, networkID, subnetID)
+}
+
+// CloudDeleteSubnetCtx is CloudDeleteSubnet with a context
+func (c *Client) CloudDeleteSubnetCtx(ctx context.Context, projectID, networkID, subnetID string) error {
+	err := c.delete(ctx, networkPrivatePath(projectID, networkID, "subnet/"+url.QueryEscape(subnetID)), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
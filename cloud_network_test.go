@@ -0,0 +1,96 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudNetworkPrivate(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddRegion(project.ID, ovh.Region{Name: "GRA3", Region: "GRA3"})
+	server.AddRegion(project.ID, ovh.Region{Name: "SBG5", Region: "SBG5"})
+
+	network, err := client.CloudCreateNetworkPrivateWithOpts(project.ID, ovh.NetworkPrivateCreateOpts{Name: "backend", VlanID: 42, Regions: []string{"GRA3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(network.Regions) != 1 || network.Regions[0].Region != "GRA3" || network.Regions[0].Status != "BUILDING" {
+		t.Fatalf("expected the network to be built in GRA3, got %+v", network.Regions)
+	}
+
+	statuses := []string{}
+	network, err = client.WaitNetworkPrivateActive(context.Background(), project.ID, network.ID,
+		ovh.WithWaitInterval(time.Millisecond),
+		ovh.WithWaitProgress(func(status string, elapsed time.Duration) { statuses = append(statuses, status) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if network.Regions[0].Status != "ACTIVE" || len(statuses) != 2 || statuses[0] != "GRA3:BUILDING" {
+		t.Fatalf("expected the network to become active, got %v", statuses)
+	}
+
+	if _, err = client.CloudCreateNetworkPrivate(project.ID, "frontend", "", 42); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict on a used VLAN, got %v", err)
+	}
+	all, err := client.CloudCreateNetworkPrivate(project.ID, "frontend", "", 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Regions) != 2 {
+		t.Fatalf("expected the network to be in all regions, got %+v", all.Regions)
+	}
+
+	if network, err = client.CloudAddNetworkPrivateRegion(project.ID, network.ID, "SBG5"); err != nil {
+		t.Fatal(err)
+	}
+	if len(network.Regions) != 2 || network.Regions[1].Status != "BUILDING" {
+		t.Fatalf("expected the network to be built in SBG5, got %+v", network.Regions)
+	}
+
+	// subnets
+	subnet, err := client.CloudCreateSubnet(project.ID, network.ID, ovh.SubnetCreateOpts{
+		Region: "GRA3", Network: "10.0.0.0/24", Start: "10.0.0.2", End: "10.0.0.254", DHCP: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subnet.CIDR != "10.0.0.0/24" || subnet.GatewayIP != "10.0.0.1" || len(subnet.IPPools) != 1 || !subnet.IPPools[0].DHCP {
+		t.Fatalf("unexpected subnet %+v", subnet)
+	}
+	isolated, err := client.CloudCreateSubnet(project.ID, network.ID, ovh.SubnetCreateOpts{
+		Region: "GRA3", Network: "10.0.1.0/24", Start: "10.0.1.10", End: "10.0.1.20", NoGateway: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isolated.GatewayIP != "" {
+		t.Fatalf("expected no gateway, got %s", isolated.GatewayIP)
+	}
+	_, err = client.CloudCreateSubnet(project.ID, network.ID, ovh.SubnetCreateOpts{Region: "GRA3", Network: "10.0.2.0/24", Start: "10.0.3.1", End: "10.0.3.9"})
+	if !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an error on a pool outside of the network, got %v", err)
+	}
+
+	if err = client.CloudDeleteSubnet(project.ID, network.ID, subnet.ID); err != nil {
+		t.Fatal(err)
+	}
+	subnets, err := client.CloudListSubnets(project.ID, network.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subnets) != 1 || subnets[0].ID != isolated.ID {
+		t.Fatalf("unexpected subnets %+v", subnets)
+	}
+
+	if err = client.CloudDeleteNetworkPrivate(project.ID, network.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudGetNetworkPrivate(project.ID, network.ID)
+	checkNotFound(t, err)
+}
@@ -0,0 +1,115 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Quota is a go representation of the limits and the usage of a Cloud
+// project in a region
+type Quota struct {
+	Region   string         `json:"region,omitempty"`
+	Instance *InstanceQuota `json:"instance,omitempty"`
+	Volume   *VolumeQuota   `json:"volume,omitempty"`
+	Keypair  *KeypairQuota  `json:"keypair,omitempty"`
+}
+
+// InstanceQuota is the limits and the usage of instances in a region
+type InstanceQuota struct {
+	MaxInstances  int `json:"maxInstances"`
+	UsedInstances int `json:"usedInstances"`
+	MaxCores      int `json:"maxCores"`
+	UsedCores     int `json:"usedCores"`
+	MaxRAM        int `json:"maxRam"`
+	UsedRAM       int `json:"usedRAM"`
+}
+
+// VolumeQuota is the limits and the usage of volumes in a region
+type VolumeQuota struct {
+	MaxVolumeCount int `json:"maxVolumeCount"`
+	VolumeCount    int `json:"volumeCount"`
+	MaxGigabytes   int `json:"maxGigabytes"`
+	UsedGigabytes  int `json:"usedGigabytes"`
+}
+
+// KeypairQuota is the limit of SSH keys in a region
+type KeypairQuota struct {
+	MaxCount int `json:"maxCount"`
+}
+
+// CheckInstances returns an error of category ErrQuotaExceeded if count more
+// instances of flavor exceed the instance or core quota. The RAM is not
+// checked, the unit of its quota not being known.
+func (q *Quota) CheckInstances(flavor Flavor, count int) error {
+	if q.Instance == nil {
+		return nil
+	}
+	i := q.Instance
+	checks := []struct {
+		resource          string
+		needed, max, used int
+	}{
+		{"instances", count, i.MaxInstances, i.UsedInstances},
+		{"cores", count * flavor.Vcpus, i.MaxCores, i.UsedCores},
+	}
+	for _, c := range checks {
+		if c.used+c.needed > c.max {
+			return fmt.Errorf("%d instances of flavor %s need %d %s in region %s, %d left of %d: %w",
+				count, flavor.Name, c.needed, c.resource, q.Region, c.max-c.used, c.max, ErrQuotaExceeded)
+		}
+	}
+	return nil
+}
+
+// CloudListQuotas returns the quotas of a project, by region
+func (c *Client) CloudListQuotas(projectID string) ([]Quota, error) {
+	return c.CloudListQuotasCtx(context.Background(), projectID)
+}
+
+// CloudListQuotasCtx is CloudListQuotas with a context
+func (c *Client) CloudListQuotasCtx(ctx context.Context, projectID string) ([]Quota, error) {
+	path := fmt.Sprintf("/cloud/project/%s/quota", url.QueryEscape(projectID))
+	quotas := []Quota{}
+	return quotas, c.get(ctx, path, &quotas)
+}
+
+// CloudQuota returns the quota of a project in a region
+func (c *Client) CloudQuota(projectID, region string) (*Quota, error) {
+	return c.CloudQuotaCtx(context.Background(), projectID, region)
+}
+
+// CloudQuotaCtx is CloudQuota with a context
+func (c *Client) CloudQuotaCtx(ctx context.Context, projectID, region string) (*Quota, error) {
+	quotas, err := c.CloudListQuotasCtx(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range quotas {
+		if quotas[i].Region == region {
+			return &quotas[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Quota of region %s %w", region, ErrNotFound)
+}
+
+// CloudCheckInstanceQuota returns an error of category ErrQuotaExceeded if
+// count instances of flavor exceed the quota of region, before creating them.
+// Regions without a known quota pass the check.
+func (c *Client) CloudCheckInstanceQuota(projectID, region string, flavor Flavor, count int) error {
+	return c.CloudCheckInstanceQuotaCtx(context.Background(), projectID, region, flavor, count)
+}
+
+// CloudCheckInstanceQuotaCtx is CloudCheckInstanceQuota with a context
+func (c *Client) CloudCheckInstanceQuotaCtx(ctx context.Context, projectID, region string, flavor Flavor, count int) error {
+	quota, err := c.CloudQuotaCtx(ctx, projectID, region)
+	if errors.Is(err, ErrNotFound) {
+		// no limit known, the API checks it on creation
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return quota.CheckInstances(flavor, count)
+}
@@ -0,0 +1,81 @@
+package ovh_test
+
+import (
+	"errors"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudQuotas(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddRegion(project.ID, ovh.Region{Name: "GRA3"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "b2-7", Region: "SBG5", Vcpus: 2, MemoryGB: 7})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 24.04"})
+	server.SetQuota(project.ID, ovh.Quota{Region: "SBG5", Instance: &ovh.InstanceQuota{MaxInstances: 10, MaxCores: 6, MaxRAM: 65536}})
+	server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "SBG5", Flavor: &flavor})
+	server.AddVolume(project.ID, ovh.Volume{Name: "data", Region: "SBG5", Size: 50})
+
+	quotas, err := client.CloudListQuotas(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotas) != 2 || quotas[0].Region != "GRA3" || quotas[1].Region != "SBG5" {
+		t.Fatalf("unexpected quotas %+v", quotas)
+	}
+
+	quota, err := client.CloudQuota(project.ID, "SBG5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *quota.Instance != (ovh.InstanceQuota{MaxInstances: 10, UsedInstances: 1, MaxCores: 6, UsedCores: 2, MaxRAM: 65536, UsedRAM: 7168}) {
+		t.Fatalf("unexpected instance quota %+v", quota.Instance)
+	}
+	if quota.Volume.VolumeCount != 1 || quota.Volume.UsedGigabytes != 50 {
+		t.Fatalf("unexpected volume quota %+v", quota.Volume)
+	}
+	if _, err = client.CloudQuota(project.ID, "BHS5"); !errors.Is(err, ovh.ErrNotFound) {
+		t.Fatalf("expected no quota in BHS5, got %v", err)
+	}
+
+	if err = client.CloudCheckInstanceQuota(project.ID, "SBG5", flavor, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.CloudCheckInstanceQuota(project.ID, "BHS5", flavor, 100); err != nil {
+		t.Fatalf("expected a region without quota to pass the check, got %v", err)
+	}
+	err = client.CloudCheckInstanceQuota(project.ID, "SBG5", flavor, 3)
+	if !errors.Is(err, ovh.ErrQuotaExceeded) {
+		t.Fatalf("expected the cores to exceed the quota, got %v", err)
+	}
+	if err.Error() != "3 instances of flavor b2-7 need 6 cores in region SBG5, 4 left of 6: quota exceeded" {
+		t.Fatalf("unexpected error message %q", err)
+	}
+
+	// the API enforces the quota too
+	opts := ovh.InstanceCreateOpts{Name: "web", FlavorID: flavor.ID, ImageID: image.ID, Region: "SBG5"}
+	instances, err := client.CloudCreateInstances(project.ID, opts, 3)
+	if !errors.Is(err, ovh.ErrQuotaExceeded) || len(instances) != 2 {
+		t.Fatalf("expected the third instance to exceed the quota, got %d instances and %v", len(instances), err)
+	}
+}
+
+func TestQuotaCheckInstances(t *testing.T) {
+	quota := ovh.Quota{Region: "GRA3", Instance: &ovh.InstanceQuota{MaxInstances: 4, MaxCores: 8, UsedCores: 2, MaxRAM: 8192, UsedRAM: 2048}}
+	flavor := ovh.Flavor{Name: "b2-7", Vcpus: 2, MemoryGB: 7}
+
+	// the RAM is not checked
+	if err := quota.CheckInstances(flavor, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := quota.CheckInstances(flavor, 4); !errors.Is(err, ovh.ErrQuotaExceeded) {
+		t.Fatalf("expected the cores to exceed the quota, got %v", err)
+	}
+	if err := quota.CheckInstances(flavor, 5); err == nil || err.Error() != "5 instances of flavor b2-7 need 5 instances in region GRA3, 4 left of 4: quota exceeded" {
+		t.Fatalf("expected the instances to exceed the quota, got %v", err)
+	}
+	if err := (&ovh.Quota{}).CheckInstances(flavor, 100); err != nil {
+		t.Fatalf("expected no instance quota to pass, got %v", err)
+	}
+}
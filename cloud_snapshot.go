@@ -0,0 +1,167 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SnapshotTimeFormat is the time format of the default names of the snapshots
+// of an instance, <instance>-<time>
+const SnapshotTimeFormat = "20060102-150405"
+
+// snapshotListInterval and snapshotListTimeout are the interval and timeout of
+// CloudCreateSnapshot polling for the new snapshot to be listed
+const (
+	snapshotListInterval = time.Second
+	snapshotListTimeout  = time.Minute
+)
+
+// SnapshotReq defines the fields for a VM snapshot
+type SnapshotReq struct {
+	SnapshotName string `json:"snapshotName"`
+}
+
+// CloudCreateSnapshot snapshots the disk of a VM in a new image named name,
+// and returns the snapshot. The snapshot is usable once "active", see
+// WaitSnapshotStatus. The API does not return the snapshot, it is looked for
+// in the list of snapshots: when it is not listed in time, the snapshot is
+// returned with its name and region only, without ID, and without error as
+// it is created.
+func (c *Client) CloudCreateSnapshot(projectID, instanceID, name string) (*Image, error) {
+	return c.CloudCreateSnapshotCtx(context.Background(), projectID, instanceID, name)
+}
+
+// CloudCreateSnapshotCtx is CloudCreateSnapshot with a context
+func (c *Client) CloudCreateSnapshotCtx(ctx context.Context, projectID, instanceID, name string) (*Image, error) {
+	instance, err := c.CloudInfoInstanceCtx(ctx, projectID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API does not return the snapshot: it is the one with this name not
+	// listed before the request
+	snapshots, err := c.CloudProjectSnapshotsListCtx(ctx, projectID, instance.Region)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, snapshot := range snapshots {
+		existing[snapshot.ID] = true
+	}
+
+	err = c.post(ctx, instanceActionPath(projectID, instanceID, "snapshot"), SnapshotReq{SnapshotName: name}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot *Image
+	err = Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		snapshots, err := c.CloudProjectSnapshotsListCtx(ctx, projectID, instance.Region)
+		if err != nil {
+			return "", false, err
+		}
+		for i := range snapshots {
+			if snapshots[i].Name == name && !existing[snapshots[i].ID] {
+				snapshot = &snapshots[i]
+				return snapshot.Status, true, nil
+			}
+		}
+		return "", false, nil
+	}, WithWaitInterval(snapshotListInterval), WithWaitTimeout(snapshotListTimeout))
+	if err != nil {
+		// the snapshot is created, failing would have it created again
+		return &Image{Name: name, Region: instance.Region}, nil
+	}
+	return snapshot, nil
+}
+
+// CloudInfoSnapshot returns a snapshot
+func (c *Client) CloudInfoSnapshot(projectID, snapshotID string) (*Image, error) {
+	return c.CloudInfoSnapshotCtx(context.Background(), projectID, snapshotID)
+}
+
+// CloudInfoSnapshotCtx is CloudInfoSnapshot with a context
+func (c *Client) CloudInfoSnapshotCtx(ctx context.Context, projectID, snapshotID string) (*Image, error) {
+	path := fmt.Sprintf("/cloud/project/%s/snapshot/%s", url.QueryEscape(projectID), url.QueryEscape(snapshotID))
+	snapshot := &Image{}
+	return snapshot, c.get(ctx, path, snapshot)
+}
+
+// CloudDeleteSnapshot deletes a snapshot
+func (c *Client) CloudDeleteSnapshot(projectID, snapshotID string) error {
+	return c.CloudDeleteSnapshotCtx(context.Background(), projectID, snapshotID)
+}
+
+// CloudDeleteSnapshotCtx is CloudDeleteSnapshot with a context
+func (c *Client) CloudDeleteSnapshotCtx(ctx context.Context, projectID, snapshotID string) error {
+	path := fmt.Sprintf("/cloud/project/%s/snapshot/%s", url.QueryEscape(projectID), url.QueryEscape(snapshotID))
+	err := c.delete(ctx, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// InstanceSnapshotName returns the default name of a snapshot of instance
+// taken at t
+func InstanceSnapshotName(instance string, t time.Time) string {
+	return instance + "-" + t.UTC().Format(SnapshotTimeFormat)
+}
+
+// IsInstanceSnapshotName tells whether name is the default name of a snapshot
+// of instance, and not of another instance with a name starting the same
+func IsInstanceSnapshotName(name, instance string) bool {
+	if !strings.HasPrefix(name, instance+"-") {
+		return false
+	}
+	_, err := time.Parse(SnapshotTimeFormat, strings.TrimPrefix(name, instance+"-"))
+	return err == nil
+}
+
+// SnapshotsToPrune returns the snapshots with a name matching match but the
+// keep most recent ones, oldest first
+func SnapshotsToPrune(snapshots []Image, match func(name string) bool, keep int) []Image {
+	matching := []Image{}
+	for _, snapshot := range snapshots {
+		if match(snapshot.Name) {
+			matching = append(matching, snapshot)
+		}
+	}
+	if len(matching) <= keep {
+		return []Image{}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].CreationDate < matching[j].CreationDate
+	})
+	return matching[:len(matching)-keep]
+}
+
+// CloudPruneSnapshots deletes the snapshots of region with a name matching
+// match, or of all regions if empty, but the keep most recent ones. It returns
+// the snapshots deleted, and those deleted before an error along with it.
+func (c *Client) CloudPruneSnapshots(projectID, region string, match func(name string) bool, keep int) ([]Image, error) {
+	return c.CloudPruneSnapshotsCtx(context.Background(), projectID, region, match, keep)
+}
+
+// CloudPruneSnapshotsCtx is CloudPruneSnapshots with a context
+func (c *Client) CloudPruneSnapshotsCtx(ctx context.Context, projectID, region string, match func(name string) bool, keep int) ([]Image, error) {
+	snapshots, err := c.CloudProjectSnapshotsListCtx(ctx, projectID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []Image{}
+	for _, snapshot := range SnapshotsToPrune(snapshots, match, keep) {
+		if err := c.CloudDeleteSnapshotCtx(ctx, projectID, snapshot.ID); err != nil {
+			return deleted, fmt.Errorf("Snapshot %s: %w", snapshot.Name, err)
+		}
+		deleted = append(deleted, snapshot)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,111 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudSnapshots(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3", DiskSpaceGB: 10})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3", User: "ubuntu"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3", Flavor: &flavor, Image: &image})
+	// an older snapshot with the same name, dated later by a skewed clock
+	older := server.AddSnapshot(project.ID, ovh.Image{Name: "web-1-nightly", Region: "GRA3", CreationDate: "2099-01-01T00:00:00Z"})
+
+	snapshot, err := client.CloudCreateSnapshot(project.ID, instance.ID, "web-1-nightly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.ID == "" || snapshot.ID == older.ID || snapshot.Status != "saving" || snapshot.Region != "GRA3" || snapshot.MinDisk != 10 || snapshot.User != "ubuntu" {
+		t.Fatalf("unexpected snapshot %+v", snapshot)
+	}
+
+	got, err := client.WaitSnapshotStatus(context.Background(), project.ID, snapshot.ID, "active", ovh.WithWaitInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "web-1-nightly" || got.Status != "active" {
+		t.Fatalf("expected the snapshot to be active, got %+v", got)
+	}
+
+	if err = client.CloudDeleteSnapshot(project.ID, snapshot.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoSnapshot(project.ID, snapshot.ID)
+	checkNotFound(t, err)
+	_, err = client.WaitSnapshotStatus(context.Background(), project.ID, snapshot.ID, "active", ovh.WithWaitInterval(time.Millisecond))
+	checkNotFound(t, err)
+
+	server.SetInstanceStatus(project.ID, instance.ID, "SHELVED_OFFLOADED")
+	if _, err = client.CloudCreateSnapshot(project.ID, instance.ID, "web-1-shelved"); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict snapshotting a shelved instance, got %v", err)
+	}
+
+	killed := server.AddSnapshot(project.ID, ovh.Image{Name: "web-1-killed", Region: "GRA3", Status: "killed"})
+	_, err = client.WaitSnapshotStatus(context.Background(), project.ID, killed.ID, "active", ovh.WithWaitInterval(time.Millisecond))
+	var statusErr *ovh.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Status != "killed" {
+		t.Fatalf("expected a killed status, got %v", err)
+	}
+}
+
+func TestCloudPruneSnapshots(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	for _, day := range []string{"03", "01", "04", "02"} {
+		server.AddSnapshot(project.ID, ovh.Image{ID: "web-" + day, Name: "web-1-201801" + day + "-020000", Region: "GRA3", CreationDate: "2018-01-" + day + "T02:00:00Z"})
+	}
+	server.AddSnapshot(project.ID, ovh.Image{ID: "web-db", Name: "web-1-db-20171231-020000", Region: "GRA3", CreationDate: "2017-12-31T02:00:00Z"})
+	server.AddSnapshot(project.ID, ovh.Image{ID: "db", Name: "db-1-20180101-020000", Region: "GRA3", CreationDate: "2018-01-01T02:00:00Z"})
+
+	web1 := func(name string) bool {
+		return ovh.IsInstanceSnapshotName(name, "web-1")
+	}
+	snapshots, err := client.CloudProjectSnapshotsList(project.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned := ovh.SnapshotsToPrune(snapshots, web1, 4); len(pruned) != 0 {
+		t.Fatalf("expected nothing to prune, got %+v", pruned)
+	}
+
+	deleted, err := client.CloudPruneSnapshots(project.ID, "", web1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 2 || deleted[0].ID != "web-01" || deleted[1].ID != "web-02" {
+		t.Fatalf("expected the 2 oldest snapshots of web-1 to be deleted, got %+v", deleted)
+	}
+
+	snapshots, err = client.CloudProjectSnapshotsList(project.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 4 || snapshots[0].ID != "db" || snapshots[3].ID != "web-db" {
+		t.Fatalf("expected the other snapshots to be kept, got %+v", snapshots)
+	}
+}
+
+func TestIsInstanceSnapshotName(t *testing.T) {
+	name := ovh.InstanceSnapshotName("web-1", time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC))
+	if name != "web-1-20180102-030405" {
+		t.Fatalf("unexpected name %s", name)
+	}
+	for name, want := range map[string]bool{
+		"web-1-20180102-030405":    true,
+		"web-1-db-20180102-030405": false,
+		"web-10-20180102-030405":   false,
+		"web-1-nightly":            false,
+		"web-1-":                   false,
+	} {
+		if got := ovh.IsInstanceSnapshotName(name, "web-1"); got != want {
+			t.Errorf("IsInstanceSnapshotName(%s, web-1) = %t, want %t", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package ovh
+
+import (
+	"bufio"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Actions of CloudSyncSSHKeys
+const (
+	SSHKeyAdded     = "added"
+	SSHKeyRemoved   = "removed"
+	SSHKeyUnchanged = "unchanged"
+)
+
+// SSHKeyFingerprint returns the MD5 fingerprint of a public key in the
+// authorized_keys format, such as "ssh-ed25519 AAAA... laptop", as computed
+// by the API: 16 hexadecimal bytes separated by colons
+func SSHKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("Invalid public key %q", publicKey)
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("Invalid public key %q: %s", publicKey, err)
+	}
+	sum := md5.Sum(blob)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hex, ":"), nil
+}
+
+// ParseAuthorizedKeys returns the public keys of data in the authorized_keys
+// format, one per line, without blank lines and comments
+func ParseAuthorizedKeys(data string) []string {
+	keys := []string{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+	return keys
+}
+
+// SSHKeySyncAction is a change, or the lack of change, made by CloudSyncSSHKeys
+type SSHKeySyncAction struct {
+	ProjectID   string `json:"projectId"`
+	Action      string `json:"action"`
+	Name        string `json:"name"`
+	ID          string `json:"id,omitempty"`
+	Fingerprint string `json:"fingerPrint"`
+}
+
+// SSHKeySyncOpts defines the options of CloudSyncSSHKeys
+type SSHKeySyncOpts struct {
+	// Remove deletes the keys of the project missing from the keys to sync
+	Remove bool
+	// DryRun returns the actions without making them
+	DryRun bool
+}
+
+// projectFingerprints returns the keys of a project by fingerprint. A key can
+// be uploaded several times, under different names.
+func (c *Client) projectFingerprints(ctx context.Context, projectID string) (map[string][]Sshkey, error) {
+	sshkeys, err := c.CloudProjectSSHKeyListCtx(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	fingerprints := map[string][]Sshkey{}
+	for _, k := range sshkeys {
+		fingerprint := k.Fingerprint
+		if f, err := SSHKeyFingerprint(k.PublicKey); err == nil {
+			fingerprint = f
+		}
+		fingerprints[fingerprint] = append(fingerprints[fingerprint], k)
+	}
+	return fingerprints, nil
+}
+
+// CloudImportSSHKey uploads a public key to a project unless a key with the
+// same fingerprint is already there, whatever its name. It returns the key,
+// and whether it was uploaded.
+func (c *Client) CloudImportSSHKey(projectID, name, publicKey string) (*Sshkey, bool, error) {
+	return c.CloudImportSSHKeyCtx(context.Background(), projectID, name, publicKey)
+}
+
+// CloudImportSSHKeyCtx is CloudImportSSHKey with a context
+func (c *Client) CloudImportSSHKeyCtx(ctx context.Context, projectID, name, publicKey string) (*Sshkey, bool, error) {
+	fingerprint, err := SSHKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, false, err
+	}
+	existing, err := c.projectFingerprints(ctx, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+	if keys, ok := existing[fingerprint]; ok {
+		k := keys[0]
+		k.Fingerprint = fingerprint
+		return &k, false, nil
+	}
+	k, err := c.CloudProjectSSHKeyCreateCtx(ctx, projectID, publicKey, name)
+	if err != nil {
+		return nil, false, err
+	}
+	k.Fingerprint = fingerprint
+	return &k, true, nil
+}
+
+// CloudSyncSSHKeys uploads the keys, by name, missing from a project, compared
+// by fingerprint, and deletes the other keys of the project with opts.Remove,
+// along with the extra copies of the keys uploaded several times. It returns
+// the action on every key.
+func (c *Client) CloudSyncSSHKeys(projectID string, keys []SshkeyReq, opts SSHKeySyncOpts) ([]SSHKeySyncAction, error) {
+	return c.CloudSyncSSHKeysCtx(context.Background(), projectID, keys, opts)
+}
+
+// CloudSyncSSHKeysCtx is CloudSyncSSHKeys with a context
+func (c *Client) CloudSyncSSHKeysCtx(ctx context.Context, projectID string, keys []SshkeyReq, opts SSHKeySyncOpts) ([]SSHKeySyncAction, error) {
+	existing, err := c.projectFingerprints(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := []SSHKeySyncAction{}
+	// kept are the IDs of the project keys in the keyring, one per fingerprint
+	kept := map[string]bool{}
+	synced := map[string]bool{}
+	for _, key := range keys {
+		fingerprint, err := SSHKeyFingerprint(key.PublicKey)
+		if err != nil {
+			return actions, err
+		}
+		if synced[fingerprint] {
+			continue
+		}
+		synced[fingerprint] = true
+
+		action := SSHKeySyncAction{ProjectID: projectID, Action: SSHKeyUnchanged, Name: key.Name, Fingerprint: fingerprint}
+		if copies, ok := existing[fingerprint]; ok {
+			// keep the copy named as in the keyring, or the first one
+			k := copies[0]
+			for _, sshkey := range copies {
+				if sshkey.Name == key.Name {
+					k = sshkey
+					break
+				}
+			}
+			kept[k.ID] = true
+			action.Name, action.ID = k.Name, k.ID
+		} else {
+			action.Action = SSHKeyAdded
+			if !opts.DryRun {
+				k, err := c.CloudProjectSSHKeyCreateCtx(ctx, projectID, key.PublicKey, key.Name)
+				if err != nil {
+					return actions, err
+				}
+				action.ID = k.ID
+			}
+		}
+		actions = append(actions, action)
+	}
+
+	if !opts.Remove {
+		return actions, nil
+	}
+	removed := []SSHKeySyncAction{}
+	for fingerprint, copies := range existing {
+		for _, k := range copies {
+			if !kept[k.ID] {
+				removed = append(removed, SSHKeySyncAction{ProjectID: projectID, Action: SSHKeyRemoved, Name: k.Name, ID: k.ID, Fingerprint: fingerprint})
+			}
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool {
+		if removed[i].Name != removed[j].Name {
+			return removed[i].Name < removed[j].Name
+		}
+		return removed[i].ID < removed[j].ID
+	})
+	for _, action := range removed {
+		if !opts.DryRun {
+			if err := c.CloudProjectSSHKeyDeleteCtx(ctx, projectID, action.ID); err != nil {
+				return actions, err
+			}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
@@ -0,0 +1,148 @@
+package ovh_test
+
+import (
+	"reflect"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+const (
+	alice            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMRpMxlW7/P7q2KHj7Up8Aw1oyH84hGCoZXl7V14AedH alice@laptop"
+	aliceFingerprint = "90:73:17:2e:05:e5:cb:10:b2:1f:79:5d:22:f3:74:73"
+	bob              = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBfYlYRm65CywO7bPsLH4MQgNBaUISTZTeMGxDxdxPc1 bob"
+	bobFingerprint   = "f9:59:2b:0a:ca:9e:43:c8:c2:8f:51:5b:ba:d7:aa:0a"
+)
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	// the same as ssh-keygen -l -E md5
+	if fingerprint, err := ovh.SSHKeyFingerprint(alice); err != nil || fingerprint != aliceFingerprint {
+		t.Fatalf("unexpected fingerprint %s, %v", fingerprint, err)
+	}
+	for _, invalid := range []string{"", "ssh-ed25519", "ssh-ed25519 not-base64!"} {
+		if _, err := ovh.SSHKeyFingerprint(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+
+	keys := ovh.ParseAuthorizedKeys("# team\n" + alice + "\n\n  " + bob + "  \n")
+	if !reflect.DeepEqual(keys, []string{alice, bob}) {
+		t.Fatalf("unexpected keys %q", keys)
+	}
+}
+
+func TestCloudImportSSHKey(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddSSHKey(project.ID, ovh.Sshkey{Name: "alice-old", PublicKey: alice})
+
+	// a key with the same fingerprint is skipped, whatever its name
+	sshkey, created, err := client.CloudImportSSHKey(project.ID, "alice", alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created || sshkey.Name != "alice-old" || sshkey.Fingerprint != aliceFingerprint {
+		t.Fatalf("expected the existing key, got %+v, created %t", sshkey, created)
+	}
+
+	sshkey, created, err = client.CloudImportSSHKey(project.ID, "bob", bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created || sshkey.Name != "bob" || sshkey.Fingerprint != bobFingerprint {
+		t.Fatalf("expected a new key, got %+v, created %t", sshkey, created)
+	}
+
+	if _, _, err = client.CloudImportSSHKey(project.ID, "broken", "not a key"); err == nil {
+		t.Fatal("expected an error for an invalid key")
+	}
+}
+
+func TestCloudSyncSSHKeys(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-alice", Name: "alice", PublicKey: alice})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-old", Name: "former-employee", PublicKey: "ssh-rsa AAAA old"})
+
+	keyring := []ovh.SshkeyReq{{Name: "alice", PublicKey: alice}, {Name: "bob", PublicKey: bob}, {Name: "bob-again", PublicKey: bob}}
+
+	// a dry run changes nothing
+	actions, err := client.CloudSyncSSHKeys(project.ID, keyring, ovh.SSHKeySyncOpts{Remove: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ovh.SSHKeySyncAction{
+		{ProjectID: project.ID, Action: ovh.SSHKeyUnchanged, Name: "alice", ID: "k-alice", Fingerprint: aliceFingerprint},
+		{ProjectID: project.ID, Action: ovh.SSHKeyAdded, Name: "bob", Fingerprint: bobFingerprint},
+		{ProjectID: project.ID, Action: ovh.SSHKeyRemoved, Name: "former-employee", ID: "k-old", Fingerprint: "69:3e:9a:f8:4d:3d:fc:c7:1e:64:0e:00:5b:dc:5e:2e"},
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, actions)
+	}
+	if sshkeys, _ := client.CloudProjectSSHKeyList(project.ID); len(sshkeys) != 2 {
+		t.Fatalf("expected a dry run to change nothing, got %+v", sshkeys)
+	}
+
+	// without removal, other keys are kept
+	if actions, err = client.CloudSyncSSHKeys(project.ID, keyring, ovh.SSHKeySyncOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 || actions[1].Action != ovh.SSHKeyAdded || actions[1].ID == "" {
+		t.Fatalf("unexpected actions %+v", actions)
+	}
+
+	if actions, err = client.CloudSyncSSHKeys(project.ID, keyring, ovh.SSHKeySyncOpts{Remove: true}); err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 3 || actions[1].Action != ovh.SSHKeyUnchanged || actions[2].Action != ovh.SSHKeyRemoved {
+		t.Fatalf("unexpected actions %+v", actions)
+	}
+	sshkeys, err := client.CloudProjectSSHKeyList(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sshkeys) != 2 || sshkeys[0].Name != "alice" || sshkeys[1].Name != "bob" {
+		t.Fatalf("expected the keys of the keyring, got %+v", sshkeys)
+	}
+}
+
+func TestCloudSyncSSHKeysDuplicates(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-laptop", Name: "alice-laptop", PublicKey: alice})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-alice", Name: "alice", PublicKey: alice})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-bob-1", Name: "bob-1", PublicKey: bob})
+	server.AddSSHKey(project.ID, ovh.Sshkey{ID: "k-bob-2", Name: "bob-2", PublicKey: bob})
+
+	keyring := []ovh.SshkeyReq{{Name: "alice", PublicKey: alice}}
+
+	// without removal, the copies are kept
+	actions, err := client.CloudSyncSSHKeys(project.ID, keyring, ovh.SSHKeySyncOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 1 || actions[0].ID != "k-alice" {
+		t.Fatalf("expected the copy named as in the keyring to be kept, got %+v", actions)
+	}
+
+	actions, err = client.CloudSyncSSHKeys(project.ID, keyring, ovh.SSHKeySyncOpts{Remove: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ovh.SSHKeySyncAction{
+		{ProjectID: project.ID, Action: ovh.SSHKeyUnchanged, Name: "alice", ID: "k-alice", Fingerprint: aliceFingerprint},
+		{ProjectID: project.ID, Action: ovh.SSHKeyRemoved, Name: "alice-laptop", ID: "k-laptop", Fingerprint: aliceFingerprint},
+		{ProjectID: project.ID, Action: ovh.SSHKeyRemoved, Name: "bob-1", ID: "k-bob-1", Fingerprint: bobFingerprint},
+		{ProjectID: project.ID, Action: ovh.SSHKeyRemoved, Name: "bob-2", ID: "k-bob-2", Fingerprint: bobFingerprint},
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, actions)
+	}
+	sshkeys, err := client.CloudProjectSSHKeyList(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sshkeys) != 1 || sshkeys[0].ID != "k-alice" {
+		t.Fatalf("expected a single copy of the key, got %+v", sshkeys)
+	}
+}
@@ -0,0 +1,197 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-ini/ini"
+)
+
+// Types of storage containers
+const (
+	// ContainerPrivate containers are only readable with credentials
+	ContainerPrivate = "private"
+	// ContainerPublic containers are readable by anyone
+	ContainerPublic = "public"
+	// ContainerStatic containers are public and host a static website, served at their StaticURL
+	ContainerStatic = "static"
+)
+
+// Container is a go representation of a Cloud object storage container
+type Container struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Region string `json:"region,omitempty"`
+	// Archive containers are cold storage, cheaper but slow to read
+	Archive bool `json:"archive,omitempty"`
+	Public  bool `json:"public,omitempty"`
+	// ContainerType is ContainerPrivate, ContainerPublic or ContainerStatic
+	ContainerType string `json:"containerType,omitempty"`
+	StaticURL     string `json:"staticUrl,omitempty"`
+	StoredObjects int    `json:"storedObjects,omitempty"`
+	// StoredBytes is the size of the objects of the container, in bytes
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+}
+
+// ContainerCreateOpts defines the fields for a container creation
+type ContainerCreateOpts struct {
+	ContainerName string `json:"containerName"`
+	Region        string `json:"region"`
+	Archive       bool   `json:"archive,omitempty"`
+}
+
+// S3Credentials are the EC2 credentials of a Cloud user, authenticating on
+// the S3 API of the object storage
+type S3Credentials struct {
+	Access string `json:"access,omitempty"`
+	// Secret is only known at the creation of the credentials, see CloudS3CredentialsSecret
+	Secret   string `json:"secret,omitempty"`
+	TenantID string `json:"tenantId,omitempty"`
+	UserID   string `json:"userId,omitempty"`
+}
+
+// containerPath returns the path of a storage container
+func containerPath(projectID, containerID string) string {
+	return fmt.Sprintf("/cloud/project/%s/storage/%s", url.QueryEscape(projectID), url.QueryEscape(containerID))
+}
+
+// CloudListContainers returns the storage containers of a project
+func (c *Client) CloudListContainers(projectID string) ([]Container, error) {
+	return c.CloudListContainersCtx(context.Background(), projectID)
+}
+
+// CloudListContainersCtx is CloudListContainers with a context
+func (c *Client) CloudListContainersCtx(ctx context.Context, projectID string) ([]Container, error) {
+	path := fmt.Sprintf("/cloud/project/%s/storage", url.QueryEscape(projectID))
+	containers := []Container{}
+	return containers, c.get(ctx, path, &containers)
+}
+
+// CloudCreateContainer creates a private storage container and returns it
+func (c *Client) CloudCreateContainer(projectID string, opts ContainerCreateOpts) (*Container, error) {
+	return c.CloudCreateContainerCtx(context.Background(), projectID, opts)
+}
+
+// CloudCreateContainerCtx is CloudCreateContainer with a context
+func (c *Client) CloudCreateContainerCtx(ctx context.Context, projectID string, opts ContainerCreateOpts) (*Container, error) {
+	path := fmt.Sprintf("/cloud/project/%s/storage", url.QueryEscape(projectID))
+	container := &Container{}
+	return container, c.post(ctx, path, opts, container)
+}
+
+// CloudInfoContainer returns a storage container
+func (c *Client) CloudInfoContainer(projectID, containerID string) (*Container, error) {
+	return c.CloudInfoContainerCtx(context.Background(), projectID, containerID)
+}
+
+// CloudInfoContainerCtx is CloudInfoContainer with a context
+func (c *Client) CloudInfoContainerCtx(ctx context.Context, projectID, containerID string) (*Container, error) {
+	container := &Container{}
+	if err := c.get(ctx, containerPath(projectID, containerID), container); err != nil {
+		return nil, err
+	}
+	// the details of a container do not include its ID
+	container.ID = containerID
+	return container, nil
+}
+
+// CloudSetContainerType makes a storage container private, public or a
+// static website, with ContainerPrivate, ContainerPublic or ContainerStatic
+func (c *Client) CloudSetContainerType(projectID, containerID, containerType string) error {
+	return c.CloudSetContainerTypeCtx(context.Background(), projectID, containerID, containerType)
+}
+
+// CloudSetContainerTypeCtx is CloudSetContainerType with a context
+func (c *Client) CloudSetContainerTypeCtx(ctx context.Context, projectID, containerID, containerType string) error {
+	return c.put(ctx, containerPath(projectID, containerID), map[string]string{"containerType": containerType}, nil)
+}
+
+// CloudDeleteContainer deletes a storage container, which must be empty
+func (c *Client) CloudDeleteContainer(projectID, containerID string) error {
+	return c.CloudDeleteContainerCtx(context.Background(), projectID, containerID)
+}
+
+// CloudDeleteContainerCtx is CloudDeleteContainer with a context
+func (c *Client) CloudDeleteContainerCtx(ctx context.Context, projectID, containerID string) error {
+	err := c.delete(ctx, containerPath(projectID, containerID), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// CloudListS3Credentials returns the S3 credentials of a user, without their secrets
+func (c *Client) CloudListS3Credentials(projectID string, userID int) ([]S3Credentials, error) {
+	return c.CloudListS3CredentialsCtx(context.Background(), projectID, userID)
+}
+
+// CloudListS3CredentialsCtx is CloudListS3Credentials with a context
+func (c *Client) CloudListS3CredentialsCtx(ctx context.Context, projectID string, userID int) ([]S3Credentials, error) {
+	creds := []S3Credentials{}
+	return creds, c.get(ctx, userPath(projectID, userID, "s3Credentials"), &creds)
+}
+
+// CloudCreateS3Credentials creates S3 credentials for a user, and returns
+// them with their secret
+func (c *Client) CloudCreateS3Credentials(projectID string, userID int) (*S3Credentials, error) {
+	return c.CloudCreateS3CredentialsCtx(context.Background(), projectID, userID)
+}
+
+// CloudCreateS3CredentialsCtx is CloudCreateS3Credentials with a context
+func (c *Client) CloudCreateS3CredentialsCtx(ctx context.Context, projectID string, userID int) (*S3Credentials, error) {
+	creds := &S3Credentials{}
+	return creds, c.post(ctx, userPath(projectID, userID, "s3Credentials"), nil, creds)
+}
+
+// CloudS3CredentialsSecret returns the secret of S3 credentials of a user,
+// given their access key
+func (c *Client) CloudS3CredentialsSecret(projectID string, userID int, access string) (string, error) {
+	return c.CloudS3CredentialsSecretCtx(context.Background(), projectID, userID, access)
+}
+
+// CloudS3CredentialsSecretCtx is CloudS3CredentialsSecret with a context
+func (c *Client) CloudS3CredentialsSecretCtx(ctx context.Context, projectID string, userID int, access string) (string, error) {
+	secret := struct {
+		Secret string `json:"secret"`
+	}{}
+	err := c.post(ctx, userPath(projectID, userID, "s3Credentials/"+url.QueryEscape(access)+"/secret"), nil, &secret)
+	return secret.Secret, err
+}
+
+// CloudDeleteS3Credentials deletes S3 credentials of a user, given their access key
+func (c *Client) CloudDeleteS3Credentials(projectID string, userID int, access string) error {
+	return c.CloudDeleteS3CredentialsCtx(context.Background(), projectID, userID, access)
+}
+
+// CloudDeleteS3CredentialsCtx is CloudDeleteS3Credentials with a context
+func (c *Client) CloudDeleteS3CredentialsCtx(ctx context.Context, projectID string, userID int, access string) error {
+	err := c.delete(ctx, userPath(projectID, userID, "s3Credentials/"+url.QueryEscape(access)), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// MergeAWSCredentials returns the AWS credentials file data, such as
+// ~/.aws/credentials, with the profile set to creds. The other profiles are kept.
+func MergeAWSCredentials(data []byte, profile string, creds *S3Credentials) ([]byte, error) {
+	if creds.Access == "" || creds.Secret == "" {
+		return nil, fmt.Errorf("S3 credentials without access key or secret: %w", ErrInvalid)
+	}
+	cfg, err := ini.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid AWS credentials file: %s: %w", err, ErrInvalid)
+	}
+	section := cfg.Section(profile)
+	section.Key("aws_access_key_id").SetValue(creds.Access)
+	section.Key("aws_secret_access_key").SetValue(creds.Secret)
+
+	b := &bytes.Buffer{}
+	if _, err = cfg.WriteTo(b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
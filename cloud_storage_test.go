@@ -0,0 +1,132 @@
+package ovh_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudContainers(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	full := server.AddContainer(project.ID, ovh.Container{Name: "backups", Region: "GRA", StoredObjects: 3, StoredBytes: 1024})
+
+	container, err := client.CloudCreateContainer(project.ID, ovh.ContainerCreateOpts{ContainerName: "www", Region: "GRA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if container.ID == "" || container.ContainerType != ovh.ContainerPrivate {
+		t.Fatalf("unexpected container %+v", container)
+	}
+	if _, err = client.CloudCreateContainer(project.ID, ovh.ContainerCreateOpts{ContainerName: "www", Region: "GRA"}); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected an existing container, got %v", err)
+	}
+
+	containers, err := client.CloudListContainers(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 2 {
+		t.Fatalf("expected 2 containers, got %+v", containers)
+	}
+
+	if err = client.CloudSetContainerType(project.ID, container.ID, ovh.ContainerStatic); err != nil {
+		t.Fatal(err)
+	}
+	info, err := client.CloudInfoContainer(project.ID, container.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ID != container.ID || info.ContainerType != ovh.ContainerStatic || !info.Public || info.StaticURL == "" {
+		t.Fatalf("expected a static container, got %+v", info)
+	}
+	if err = client.CloudSetContainerType(project.ID, container.ID, "shared"); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid type, got %v", err)
+	}
+
+	if err = client.CloudDeleteContainer(project.ID, full.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a non-empty container, got %v", err)
+	}
+	if err = client.CloudDeleteContainer(project.ID, container.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoContainer(project.ID, container.ID)
+	checkNotFound(t, err)
+	if err = client.CloudDeleteContainer(project.ID, container.ID); err != nil {
+		t.Fatalf("expected deleting a deleted container to succeed, got %v", err)
+	}
+}
+
+func TestCloudS3Credentials(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	user := server.AddUser(project.ID, ovh.User{Username: "user-1"})
+	other := server.AddUser(project.ID, ovh.User{Username: "user-2"})
+
+	creds, err := client.CloudCreateS3Credentials(project.ID, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Access == "" || creds.Secret == "" || creds.TenantID != project.ID {
+		t.Fatalf("unexpected credentials %+v", creds)
+	}
+
+	list, err := client.CloudListS3Credentials(project.ID, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Access != creds.Access || list[0].Secret != "" {
+		t.Fatalf("unexpected credentials %+v", list)
+	}
+
+	secret, err := client.CloudS3CredentialsSecret(project.ID, user.ID, creds.Access)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret != creds.Secret {
+		t.Fatalf("expected secret %s, got %s", creds.Secret, secret)
+	}
+	_, err = client.CloudS3CredentialsSecret(project.ID, other.ID, creds.Access)
+	checkNotFound(t, err)
+
+	if err = client.CloudDeleteS3Credentials(project.ID, user.ID, creds.Access); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.CloudDeleteS3Credentials(project.ID, user.ID, creds.Access); err != nil {
+		t.Fatalf("expected deleting deleted credentials to succeed, got %v", err)
+	}
+}
+
+func TestMergeAWSCredentials(t *testing.T) {
+	creds := &ovh.S3Credentials{Access: "access-1", Secret: "secret-1"}
+	existing := []byte("[default]\naws_access_key_id = AKIA\naws_secret_access_key = secret\n\n[ovh]\naws_access_key_id = old\n")
+
+	data, err := ovh.MergeAWSCredentials(existing, "ovh", creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := string(data)
+	if !strings.Contains(file, "[default]\naws_access_key_id     = AKIA\n") {
+		t.Fatalf("expected the default profile to be kept, got:\n%s", file)
+	}
+	if strings.Contains(file, "old") || !strings.Contains(file, "[ovh]\naws_access_key_id     = access-1\naws_secret_access_key = secret-1\n") {
+		t.Fatalf("expected the ovh profile to be replaced, got:\n%s", file)
+	}
+
+	data, err = ovh.MergeAWSCredentials(nil, "ovh", creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "[ovh]\n") {
+		t.Fatalf("unexpected credentials file:\n%s", data)
+	}
+
+	if _, err = ovh.MergeAWSCredentials(existing, "ovh", &ovh.S3Credentials{Access: "access-1"}); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected credentials without secret to be invalid, got %v", err)
+	}
+	if _, err = ovh.MergeAWSCredentials([]byte("[ovh"), "ovh", creds); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid file, got %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhtest"
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+// newTestServer starts a fake API, closed at the end of the test, and a client for it
+func newTestServer(t *testing.T) (*ovhtest.Server, *ovh.Client) {
+	server := ovhtest.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+// checkNotFound fails the test if err is not a 404 API error
+func checkNotFound(t *testing.T, err error) {
+	t.Helper()
+	if apiErr := (*govh.APIError)(nil); !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+}
+
+func TestCloudProjects(t *testing.T) {
+	server, client := newTestServer(t)
+	server.AddProject(ovh.Project{ID: "p1", Name: "staging"})
+	server.AddProject(ovh.Project{ID: "p2", Name: "production"})
+
+	projects, err := client.CloudProjectsList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != 2 || projects[0].ID != "p1" || projects[1].ID != "p2" {
+		t.Fatalf("unexpected projects %+v", projects)
+	}
+
+	project, err := client.CloudProjectInfoByID("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if project.Name != "production" {
+		t.Fatalf("unexpected project %+v", project)
+	}
+
+	for _, name := range []string{"production", "p2"} {
+		project, err = client.CloudProjectInfoByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if project.ID != "p2" {
+			t.Fatalf("expected p2 for %s, got %+v", name, project)
+		}
+	}
+
+	if _, err = client.CloudProjectInfoByName("unknown"); err == nil {
+		t.Fatal("expected an error for an unknown project name")
+	}
+	_, err = client.CloudProjectInfoByID("unknown")
+	checkNotFound(t, err)
+}
+
+func TestCloudRegions(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddRegion(project.ID, ovh.Region{Name: "GRA3", ContinentCode: "EU"})
+	server.AddRegion(project.ID, ovh.Region{Name: "BHS3", ContinentCode: "NA"})
+
+	regions, err := client.CloudListRegions(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != 2 || regions[0].Region != "BHS3" || regions[1].Region != "GRA3" {
+		t.Fatalf("unexpected regions %+v", regions)
+	}
+
+	names, err := client.CloudProjectRegionList(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "BHS3" {
+		t.Fatalf("unexpected region names %v", names)
+	}
+
+	region, err := client.CloudInfoRegion(project.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if region.ContinentCode != "EU" || region.Status != "UP" {
+		t.Fatalf("unexpected region %+v", region)
+	}
+
+	_, err = client.CloudInfoRegion(project.ID, "SBG1")
+	checkNotFound(t, err)
+}
+
+func TestCloudInstances(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	flavor := server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3", Vcpus: 1})
+	image := server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3", User: "ubuntu"})
+	sshkey := server.AddSSHKey(project.ID, ovh.Sshkey{Name: "laptop", PublicKey: "ssh-rsa AAAA"})
+
+	instance, err := client.CloudCreateInstance(project.ID, "web-1", sshkey.ID, flavor.ID, image.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.ID == "" || instance.Status != "BUILD" || instance.Flavor.Name != "s1-2" || instance.Sshkey.Name != "laptop" {
+		t.Fatalf("unexpected instance %+v", instance)
+	}
+
+	got, err := client.CloudGetInstance(project.ID, instance.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != "ACTIVE" || len(got.IPAddresses) != 1 {
+		t.Fatalf("expected the instance to be built, got %+v", got)
+	}
+
+	got, err = client.CloudInfoInstance(project.ID, instance.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "web-1" || got.Image.User != "ubuntu" {
+		t.Fatalf("unexpected instance %+v", got)
+	}
+
+	instances, err := client.CloudListInstance(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 1 || instances[0].ID != instance.ID {
+		t.Fatalf("unexpected instances %+v", instances)
+	}
+
+	if err = client.CloudDeleteInstance(project.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudGetInstance(project.ID, instance.ID)
+	checkNotFound(t, err)
+
+	_, err = client.CloudCreateInstance(project.ID, "web-2", "", "unknown", image.ID, "GRA3")
+	checkNotFound(t, err)
+}
+
+// TestCloudGetInstanceErrors checks that CloudGetInstance returns the errors
+// of the API, it used to always return a nil error
+func TestCloudGetInstanceErrors(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+
+	_, err := client.CloudGetInstance("unknown", instance.ID)
+	checkNotFound(t, err)
+	if !errors.Is(err, ovh.ErrNotFound) {
+		t.Fatalf("expected an error of category ErrNotFound, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = client.CloudGetInstanceCtx(ctx, project.ID, instance.ID); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context to be canceled, got %v", err)
+	}
+}
+
+func TestCloudNetworks(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddPublicNetwork(project.ID, ovh.Network{Name: "Ext-Net"})
+
+	public, err := client.CloudInfoNetworkPublic(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(public) != 1 || public[0].Name != "Ext-Net" || public[0].Type != "public" {
+		t.Fatalf("unexpected public networks %+v", public)
+	}
+
+	network, err := client.CloudCreateNetworkPrivate(project.ID, "backend", "GRA3", 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if network.ID == "" || network.VlanID != 42 {
+		t.Fatalf("unexpected network %+v", network)
+	}
+
+	private, err := client.CloudInfoNetworkPrivate(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(private) != 1 || private[0].Name != "backend" || private[0].Type != "private" {
+		t.Fatalf("unexpected private networks %+v", private)
+	}
+}
+
+func TestCloudUsers(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+
+	user, err := client.CloudProjectUserCreate(project.ID, "terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID == 0 || user.Username == "" || user.Password == "" {
+		t.Fatalf("unexpected user %+v", user)
+	}
+
+	users, err := client.CloudProjectUsersList(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Description != "terraform" || users[0].Password != "" {
+		t.Fatalf("unexpected users %+v", users)
+	}
+}
+
+func TestCloudSSHKeys(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+
+	sshkey, err := client.CloudProjectSSHKeyCreate(project.ID, "ssh-ed25519 AAAA laptop", "laptop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshkeys, err := client.CloudProjectSSHKeyList(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sshkeys) != 1 || sshkeys[0].ID != sshkey.ID {
+		t.Fatalf("unexpected SSH keys %+v", sshkeys)
+	}
+
+	info, err := client.CloudProjectSSHKeyInfo(project.ID, sshkey.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "laptop" || info.PublicKey != "ssh-ed25519 AAAA laptop" {
+		t.Fatalf("unexpected SSH key %+v", info)
+	}
+
+	if err = client.CloudProjectSSHKeyDelete(project.ID, sshkey.ID); err != nil {
+		t.Fatal(err)
+	}
+	// deleting a missing key is not an error
+	if err = client.CloudProjectSSHKeyDelete(project.ID, sshkey.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.CloudProjectSSHKeyCreate(project.ID, "not a key", "broken"); err == nil {
+		t.Fatal("expected an error for an invalid public key")
+	}
+}
+
+func TestCloudImagesAndFlavors(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddImage(project.ID, ovh.Image{Name: "Ubuntu 16.04", Region: "GRA3", OS: "linux"})
+	server.AddImage(project.ID, ovh.Image{Name: "Debian 9", Region: "BHS3", OS: "linux"})
+	server.AddImage(project.ID, ovh.Image{Name: "Windows Server 2016", Region: "GRA3", OS: "windows"})
+	server.AddSnapshot(project.ID, ovh.Image{Name: "web-backup", Region: "GRA3"})
+	server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "GRA3"})
+	server.AddFlavor(project.ID, ovh.Flavor{Name: "s1-2", Region: "BHS3"})
+
+	images, err := client.CloudProjectImagesList(project.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 3 {
+		t.Fatalf("expected 3 images, got %+v", images)
+	}
+
+	images, err = client.CloudProjectImagesList(project.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("expected 2 images in GRA3, got %+v", images)
+	}
+
+	snapshots, err := client.CloudProjectSnapshotsList(project.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Visibility != "private" {
+		t.Fatalf("unexpected snapshots %+v", snapshots)
+	}
+
+	found, err := client.CloudProjectImagesSearch(project.ID, "GRA3", "Ubuntu", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 || found[0].Name != "Ubuntu 16.04" || found[1].Name != "web-backup" {
+		t.Fatalf("unexpected search results %+v", found)
+	}
+
+	flavors, err := client.CloudProjectFlavorsList(project.ID, "BHS3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(flavors) != 1 || flavors[0].Region != "BHS3" {
+		t.Fatalf("unexpected flavors %+v", flavors)
+	}
+
+	_, err = client.CloudProjectFlavorsList("unknown", "")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,194 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Groups of costs, see UsageCosts
+const (
+	CostByInstance     = "instance"
+	CostByRegion       = "region"
+	CostByResourceType = "resource-type"
+)
+
+// Usage is a go representation of the consumption of a Cloud project over a
+// period: the current month, a past one or the forecast of the current month
+type Usage struct {
+	// ID is set on the usages of the history
+	ID           string          `json:"id,omitempty"`
+	Period       UsagePeriod     `json:"period"`
+	LastUpdate   string          `json:"lastUpdate,omitempty"`
+	HourlyUsage  *UsageResources `json:"hourlyUsage,omitempty"`
+	MonthlyUsage *UsageResources `json:"monthlyUsage,omitempty"`
+}
+
+// UsagePeriod is the period of a usage
+type UsagePeriod struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// UsageResources is the consumption of a project by type of resource
+type UsageResources struct {
+	Instance []UsageItem `json:"instance,omitempty"`
+	Volume   []UsageItem `json:"volume,omitempty"`
+	Snapshot []UsageItem `json:"snapshot,omitempty"`
+	Storage  []UsageItem `json:"storage,omitempty"`
+}
+
+// UsageItem is the consumption of the resources of a reference, such as a
+// flavor or a type of volume, in a region
+type UsageItem struct {
+	Region     string        `json:"region,omitempty"`
+	Reference  string        `json:"reference,omitempty"`
+	TotalPrice OrderPrice    `json:"totalPrice"`
+	Details    []UsageDetail `json:"details,omitempty"`
+}
+
+// UsageDetail is the consumption of one resource
+type UsageDetail struct {
+	InstanceID string        `json:"instanceId,omitempty"`
+	VolumeID   string        `json:"volumeId,omitempty"`
+	Quantity   UsageQuantity `json:"quantity"`
+	TotalPrice OrderPrice    `json:"totalPrice"`
+}
+
+// UsageQuantity is a quantity consumed, such as hours or GB
+type UsageQuantity struct {
+	Unit  string  `json:"unit,omitempty"`
+	Value float64 `json:"value"`
+}
+
+// Cost is the total price of a group of resources in a currency
+type Cost struct {
+	// Group is an instance ID, a region or a type of resource, depending on
+	// the grouping, or empty for the total
+	Group    string  `json:"group,omitempty"`
+	Currency string  `json:"currency"`
+	Total    float64 `json:"total"`
+}
+
+// UsageCosts sums the prices of usages by currency, and by group if by is
+// CostByInstance, CostByRegion or CostByResourceType. Grouped by instance,
+// the costs of the other resources are grouped by type of resource. Costs are
+// rounded to cents, and sorted by group and currency.
+func UsageCosts(usages []Usage, by string) ([]Cost, error) {
+	if by != "" && by != CostByInstance && by != CostByRegion && by != CostByResourceType {
+		return nil, fmt.Errorf("Invalid grouping %s, use %s, %s or %s", by, CostByInstance, CostByRegion, CostByResourceType)
+	}
+
+	type key struct{ group, currency string }
+	totals := map[key]float64{}
+	add := func(group string, price OrderPrice) {
+		totals[key{group, price.CurrencyCode}] += float64(price.Value)
+	}
+
+	for _, usage := range usages {
+		for _, resources := range []*UsageResources{usage.HourlyUsage, usage.MonthlyUsage} {
+			if resources == nil {
+				continue
+			}
+			types := []struct {
+				name  string
+				items []UsageItem
+			}{
+				{"instance", resources.Instance},
+				{"volume", resources.Volume},
+				{"snapshot", resources.Snapshot},
+				{"storage", resources.Storage},
+			}
+			for _, t := range types {
+				for _, item := range t.items {
+					switch {
+					case by == CostByInstance && t.name == "instance" && len(item.Details) > 0:
+						for _, detail := range item.Details {
+							add(detail.InstanceID, detail.TotalPrice)
+						}
+					case by == CostByInstance || by == CostByResourceType:
+						add(t.name, item.TotalPrice)
+					case by == CostByRegion:
+						add(item.Region, item.TotalPrice)
+					default:
+						add("", item.TotalPrice)
+					}
+				}
+			}
+		}
+	}
+
+	costs := []Cost{}
+	for k, total := range totals {
+		costs = append(costs, Cost{Group: k.group, Currency: k.currency, Total: math.Round(total*100) / 100})
+	}
+	sort.Slice(costs, func(i, j int) bool {
+		if costs[i].Group != costs[j].Group {
+			return costs[i].Group < costs[j].Group
+		}
+		return costs[i].Currency < costs[j].Currency
+	})
+	return costs, nil
+}
+
+// CloudUsageCurrent returns the usage of a project since the beginning of the month
+func (c *Client) CloudUsageCurrent(projectID string) (*Usage, error) {
+	return c.CloudUsageCurrentCtx(context.Background(), projectID)
+}
+
+// CloudUsageCurrentCtx is CloudUsageCurrent with a context
+func (c *Client) CloudUsageCurrentCtx(ctx context.Context, projectID string) (*Usage, error) {
+	path := fmt.Sprintf("/cloud/project/%s/usage/current", url.QueryEscape(projectID))
+	usage := &Usage{}
+	return usage, c.get(ctx, path, usage)
+}
+
+// CloudUsageForecast returns the forecast of the usage of a project at the end of the month
+func (c *Client) CloudUsageForecast(projectID string) (*Usage, error) {
+	return c.CloudUsageForecastCtx(context.Background(), projectID)
+}
+
+// CloudUsageForecastCtx is CloudUsageForecast with a context
+func (c *Client) CloudUsageForecastCtx(ctx context.Context, projectID string) (*Usage, error) {
+	path := fmt.Sprintf("/cloud/project/%s/usage/forecast", url.QueryEscape(projectID))
+	usage := &Usage{}
+	return usage, c.get(ctx, path, usage)
+}
+
+// CloudListUsageHistory returns the past usages of a project overlapping the
+// period from-to, without their resources. Zero times leave the period open.
+func (c *Client) CloudListUsageHistory(projectID string, from, to time.Time) ([]Usage, error) {
+	return c.CloudListUsageHistoryCtx(context.Background(), projectID, from, to)
+}
+
+// CloudListUsageHistoryCtx is CloudListUsageHistory with a context
+func (c *Client) CloudListUsageHistoryCtx(ctx context.Context, projectID string, from, to time.Time) ([]Usage, error) {
+	query := url.Values{}
+	if !from.IsZero() {
+		query.Set("from", from.Format("2006-01-02"))
+	}
+	if !to.IsZero() {
+		query.Set("to", to.Format("2006-01-02"))
+	}
+	path := fmt.Sprintf("/cloud/project/%s/usage/history", url.QueryEscape(projectID))
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	usages := []Usage{}
+	return usages, c.get(ctx, path, &usages)
+}
+
+// CloudUsageHistory returns a past usage of a project, with its resources
+func (c *Client) CloudUsageHistory(projectID, usageID string) (*Usage, error) {
+	return c.CloudUsageHistoryCtx(context.Background(), projectID, usageID)
+}
+
+// CloudUsageHistoryCtx is CloudUsageHistory with a context
+func (c *Client) CloudUsageHistoryCtx(ctx context.Context, projectID, usageID string) (*Usage, error) {
+	path := fmt.Sprintf("/cloud/project/%s/usage/history/%s", url.QueryEscape(projectID), url.QueryEscape(usageID))
+	usage := &Usage{}
+	return usage, c.get(ctx, path, usage)
+}
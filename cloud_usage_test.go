@@ -0,0 +1,118 @@
+package ovh_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// eur returns a price in euros
+func eur(value float32) ovh.OrderPrice {
+	return ovh.OrderPrice{CurrencyCode: "EUR", Value: value}
+}
+
+var august = ovh.Usage{
+	Period: ovh.UsagePeriod{From: "2026-08-01T00:00:00Z", To: "2026-08-31T23:59:59Z"},
+	HourlyUsage: &ovh.UsageResources{
+		Instance: []ovh.UsageItem{
+			{Region: "GRA3", Reference: "b2-7", TotalPrice: eur(30), Details: []ovh.UsageDetail{
+				{InstanceID: "i1", TotalPrice: eur(10)},
+				{InstanceID: "i2", TotalPrice: eur(20)},
+			}},
+		},
+		Volume: []ovh.UsageItem{{Region: "SBG5", Reference: "classic", TotalPrice: eur(4)}},
+	},
+	MonthlyUsage: &ovh.UsageResources{
+		Instance: []ovh.UsageItem{
+			{Region: "SBG5", Reference: "s1-2", TotalPrice: eur(5), Details: []ovh.UsageDetail{{InstanceID: "i1", TotalPrice: eur(5)}}},
+		},
+	},
+}
+
+func TestUsageCosts(t *testing.T) {
+	usages := []ovh.Usage{august, {MonthlyUsage: &ovh.UsageResources{
+		Storage: []ovh.UsageItem{{Region: "GRA", TotalPrice: ovh.OrderPrice{CurrencyCode: "CAD", Value: 2}}},
+	}}}
+
+	tests := []struct {
+		by       string
+		expected []ovh.Cost
+	}{
+		{"", []ovh.Cost{{Currency: "CAD", Total: 2}, {Currency: "EUR", Total: 39}}},
+		{ovh.CostByInstance, []ovh.Cost{
+			{Group: "i1", Currency: "EUR", Total: 15},
+			{Group: "i2", Currency: "EUR", Total: 20},
+			{Group: "storage", Currency: "CAD", Total: 2},
+			{Group: "volume", Currency: "EUR", Total: 4},
+		}},
+		{ovh.CostByRegion, []ovh.Cost{
+			{Group: "GRA", Currency: "CAD", Total: 2},
+			{Group: "GRA3", Currency: "EUR", Total: 30},
+			{Group: "SBG5", Currency: "EUR", Total: 9},
+		}},
+		{ovh.CostByResourceType, []ovh.Cost{
+			{Group: "instance", Currency: "EUR", Total: 35},
+			{Group: "storage", Currency: "CAD", Total: 2},
+			{Group: "volume", Currency: "EUR", Total: 4},
+		}},
+	}
+	for _, test := range tests {
+		costs, err := ovh.UsageCosts(usages, test.by)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(costs, test.expected) {
+			t.Errorf("expected costs by %q %+v, got %+v", test.by, test.expected, costs)
+		}
+	}
+
+	if _, err := ovh.UsageCosts(usages, "flavor"); err == nil {
+		t.Fatal("expected an invalid grouping")
+	}
+}
+
+func TestCloudUsage(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.SetUsage(project.ID,
+		ovh.Usage{Period: ovh.UsagePeriod{From: "2026-10-01T00:00:00Z", To: "2026-10-16T12:00:00Z"}, HourlyUsage: &ovh.UsageResources{Instance: []ovh.UsageItem{{TotalPrice: eur(12)}}}},
+		ovh.Usage{Period: ovh.UsagePeriod{From: "2026-10-01T00:00:00Z", To: "2026-10-31T23:59:59Z"}, HourlyUsage: &ovh.UsageResources{Instance: []ovh.UsageItem{{TotalPrice: eur(25)}}}},
+	)
+	past := server.AddUsageHistory(project.ID, august)
+	server.AddUsageHistory(project.ID, ovh.Usage{Period: ovh.UsagePeriod{From: "2026-09-01T00:00:00Z", To: "2026-09-30T23:59:59Z"}})
+
+	current, err := client.CloudUsageCurrent(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forecast, err := client.CloudUsageForecast(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.HourlyUsage.Instance[0].TotalPrice.Value != 12 || forecast.HourlyUsage.Instance[0].TotalPrice.Value != 25 {
+		t.Fatalf("unexpected usage %+v and forecast %+v", current, forecast)
+	}
+
+	usages, err := client.CloudListUsageHistory(project.ID, time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC), time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usages) != 1 || usages[0].ID != past.ID || usages[0].HourlyUsage != nil {
+		t.Fatalf("expected the usage of August without resources, got %+v", usages)
+	}
+	if usages, err = client.CloudListUsageHistory(project.ID, time.Time{}, time.Time{}); err != nil || len(usages) != 2 {
+		t.Fatalf("expected all the usages, got %+v, %v", usages, err)
+	}
+
+	usage, err := client.CloudUsageHistory(project.ID, past.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*usage, past) {
+		t.Fatalf("expected %+v, got %+v", past, usage)
+	}
+	_, err = client.CloudUsageHistory(project.ID, "unknown")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,252 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// OpenStackAuthURL is the Keystone v3 endpoint of the OVH public cloud
+const OpenStackAuthURL = "https://auth.cloud.ovh.net/v3"
+
+// Role is a go representation of a role of Cloud users, granting them permissions
+type Role struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// UserToken is a Keystone token of a Cloud user
+type UserToken struct {
+	// AuthToken is the token, sent in the X-Auth-Token header of OpenStack requests
+	AuthToken string           `json:"X-Auth-Token,omitempty"`
+	Token     UserTokenDetails `json:"token"`
+}
+
+// UserTokenDetails are the validity dates of a Keystone token
+type UserTokenDetails struct {
+	IssuedAt  string `json:"issued_at,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// userPath returns the path of a user, or of one of its sub-resources if sub is set
+func userPath(projectID string, userID int, sub string) string {
+	path := fmt.Sprintf("/cloud/project/%s/user/%d", url.QueryEscape(projectID), userID)
+	if sub != "" {
+		path += "/" + sub
+	}
+	return path
+}
+
+// CloudInfoUser returns a user. Its password is only known at its creation,
+// see CloudRegenerateUserPassword.
+func (c *Client) CloudInfoUser(projectID string, userID int) (*User, error) {
+	return c.CloudInfoUserCtx(context.Background(), projectID, userID)
+}
+
+// CloudInfoUserCtx is CloudInfoUser with a context
+func (c *Client) CloudInfoUserCtx(ctx context.Context, projectID string, userID int) (*User, error) {
+	user := &User{}
+	return user, c.get(ctx, userPath(projectID, userID, ""), user)
+}
+
+// CloudDeleteUser deletes a user
+func (c *Client) CloudDeleteUser(projectID string, userID int) error {
+	return c.CloudDeleteUserCtx(context.Background(), projectID, userID)
+}
+
+// CloudDeleteUserCtx is CloudDeleteUser with a context
+func (c *Client) CloudDeleteUserCtx(ctx context.Context, projectID string, userID int) error {
+	err := c.delete(ctx, userPath(projectID, userID, ""), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// CloudRegenerateUserPassword sets a new password to a user, and returns the
+// user with it. The former password no longer authenticates.
+func (c *Client) CloudRegenerateUserPassword(projectID string, userID int) (*User, error) {
+	return c.CloudRegenerateUserPasswordCtx(context.Background(), projectID, userID)
+}
+
+// CloudRegenerateUserPasswordCtx is CloudRegenerateUserPassword with a context
+func (c *Client) CloudRegenerateUserPasswordCtx(ctx context.Context, projectID string, userID int) (*User, error) {
+	user := &User{}
+	return user, c.post(ctx, userPath(projectID, userID, "regeneratePassword"), nil, user)
+}
+
+// CloudUserToken returns a new Keystone token of a user, given its password
+func (c *Client) CloudUserToken(projectID string, userID int, password string) (*UserToken, error) {
+	return c.CloudUserTokenCtx(context.Background(), projectID, userID, password)
+}
+
+// CloudUserTokenCtx is CloudUserToken with a context
+func (c *Client) CloudUserTokenCtx(ctx context.Context, projectID string, userID int, password string) (*UserToken, error) {
+	token := &UserToken{}
+	return token, c.post(ctx, userPath(projectID, userID, "token"), map[string]string{"password": password}, token)
+}
+
+// CloudListRoles returns the roles which can be given to the users of a project
+func (c *Client) CloudListRoles(projectID string) ([]Role, error) {
+	return c.CloudListRolesCtx(context.Background(), projectID)
+}
+
+// CloudListRolesCtx is CloudListRoles with a context
+func (c *Client) CloudListRolesCtx(ctx context.Context, projectID string) ([]Role, error) {
+	path := fmt.Sprintf("/cloud/project/%s/role", url.QueryEscape(projectID))
+	roles := struct {
+		Roles []Role `json:"roles"`
+	}{Roles: []Role{}}
+	return roles.Roles, c.get(ctx, path, &roles)
+}
+
+// CloudListUserRoles returns the roles of a user
+func (c *Client) CloudListUserRoles(projectID string, userID int) ([]Role, error) {
+	return c.CloudListUserRolesCtx(context.Background(), projectID, userID)
+}
+
+// CloudListUserRolesCtx is CloudListUserRoles with a context
+func (c *Client) CloudListUserRolesCtx(ctx context.Context, projectID string, userID int) ([]Role, error) {
+	roles := []Role{}
+	return roles, c.get(ctx, userPath(projectID, userID, "role"), &roles)
+}
+
+// CloudAddUserRole gives a role to a user, and returns the user
+func (c *Client) CloudAddUserRole(projectID string, userID int, roleID string) (*User, error) {
+	return c.CloudAddUserRoleCtx(context.Background(), projectID, userID, roleID)
+}
+
+// CloudAddUserRoleCtx is CloudAddUserRole with a context
+func (c *Client) CloudAddUserRoleCtx(ctx context.Context, projectID string, userID int, roleID string) (*User, error) {
+	user := &User{}
+	return user, c.post(ctx, userPath(projectID, userID, "role"), map[string]string{"roleId": roleID}, user)
+}
+
+// CloudRemoveUserRole takes a role back from a user
+func (c *Client) CloudRemoveUserRole(projectID string, userID int, roleID string) error {
+	return c.CloudRemoveUserRoleCtx(context.Background(), projectID, userID, roleID)
+}
+
+// CloudRemoveUserRoleCtx is CloudRemoveUserRole with a context
+func (c *Client) CloudRemoveUserRoleCtx(ctx context.Context, projectID string, userID int, roleID string) error {
+	err := c.delete(ctx, userPath(projectID, userID, "role/"+url.QueryEscape(roleID)), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// OpenStackCredentials returns the credentials of a user on the OpenStack
+// APIs of its project, on OpenStackAuthURL. The password is empty unless the
+// user was just created or its password regenerated.
+func (u *User) OpenStackCredentials(projectID string) *OpenStackCredentials {
+	return &OpenStackCredentials{
+		AuthURL:    OpenStackAuthURL,
+		Username:   u.Username,
+		Password:   u.Password,
+		ProjectID:  projectID,
+		DomainName: "Default",
+	}
+}
+
+// shellQuote quotes s for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Openrc returns an openrc file exporting the credentials for Keystone v3 in
+// region. Without password, the file prompts for it when sourced.
+func (creds *OpenStackCredentials) Openrc(region string) string {
+	domain := creds.DomainName
+	if domain == "" {
+		domain = "Default"
+	}
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# OpenStack credentials of %s in region %s, to source in a shell\n", creds.Username, region)
+	fmt.Fprintf(b, "export OS_AUTH_URL=%s\n", shellQuote(creds.AuthURL))
+	b.WriteString("export OS_IDENTITY_API_VERSION=3\n")
+	fmt.Fprintf(b, "export OS_USER_DOMAIN_NAME=%s\n", shellQuote(domain))
+	fmt.Fprintf(b, "export OS_PROJECT_DOMAIN_NAME=%s\n", shellQuote(domain))
+	fmt.Fprintf(b, "export OS_PROJECT_ID=%s\n", shellQuote(creds.ProjectID))
+	fmt.Fprintf(b, "export OS_TENANT_ID=%s\n", shellQuote(creds.ProjectID))
+	fmt.Fprintf(b, "export OS_USERNAME=%s\n", shellQuote(creds.Username))
+	if creds.Password != "" {
+		fmt.Fprintf(b, "export OS_PASSWORD=%s\n", shellQuote(creds.Password))
+	} else {
+		b.WriteString("printf 'OpenStack password of %s: ' \"$OS_USERNAME\"\n")
+		b.WriteString("stty -echo; read -r OS_PASSWORD; stty echo; echo\n")
+		b.WriteString("export OS_PASSWORD\n")
+	}
+	fmt.Fprintf(b, "export OS_REGION_NAME=%s\n", shellQuote(region))
+	return b.String()
+}
+
+// CloudsYAMLCloud is an entry of a clouds.yaml file, the configuration of
+// the OpenStack clients
+type CloudsYAMLCloud struct {
+	Auth               CloudsYAMLAuth `json:"auth"`
+	RegionName         string         `json:"region_name"`
+	Interface          string         `json:"interface"`
+	IdentityAPIVersion int            `json:"identity_api_version"`
+}
+
+// CloudsYAMLAuth are the credentials of an entry of a clouds.yaml file
+type CloudsYAMLAuth struct {
+	AuthURL           string `json:"auth_url"`
+	Username          string `json:"username"`
+	Password          string `json:"password,omitempty"`
+	ProjectID         string `json:"project_id"`
+	UserDomainName    string `json:"user_domain_name"`
+	ProjectDomainName string `json:"project_domain_name"`
+}
+
+// CloudsYAMLCloud returns the clouds.yaml entry of the credentials in region.
+// Without password, the OpenStack clients prompt for it.
+func (creds *OpenStackCredentials) CloudsYAMLCloud(region string) CloudsYAMLCloud {
+	domain := creds.DomainName
+	if domain == "" {
+		domain = "Default"
+	}
+	return CloudsYAMLCloud{
+		Auth: CloudsYAMLAuth{
+			AuthURL:           creds.AuthURL,
+			Username:          creds.Username,
+			Password:          creds.Password,
+			ProjectID:         creds.ProjectID,
+			UserDomainName:    domain,
+			ProjectDomainName: domain,
+		},
+		RegionName:         region,
+		Interface:          "public",
+		IdentityAPIVersion: 3,
+	}
+}
+
+// MergeCloudsYAML returns the clouds.yaml file data with clouds added or
+// replaced, by name. The other entries and settings of data are kept.
+func MergeCloudsYAML(data []byte, clouds map[string]CloudsYAMLCloud) ([]byte, error) {
+	file := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("Invalid clouds.yaml: %s: %w", err, ErrInvalid)
+	}
+	if file == nil {
+		// an empty file
+		file = map[string]interface{}{}
+	}
+	existing, ok := file["clouds"].(map[string]interface{})
+	if !ok {
+		existing = map[string]interface{}{}
+	}
+
+	for name, cloud := range clouds {
+		existing[name] = cloud
+	}
+	file["clouds"] = existing
+	return yaml.Marshal(file)
+}
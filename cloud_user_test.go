@@ -0,0 +1,172 @@
+package ovh_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	"github.com/ghodss/yaml"
+)
+
+func TestCloudUserPassword(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddRegion(project.ID, ovh.Region{Name: "GRA3"})
+	created, err := client.CloudProjectUserCreate(project.ID, "terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := client.CloudInfoUser(project.ID, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Username != created.Username || user.Status != "ok" || user.Password != "" {
+		t.Fatalf("unexpected user %+v", user)
+	}
+
+	user, err = client.CloudRegenerateUserPassword(project.ID, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Password == "" || user.Password == created.Password {
+		t.Fatalf("expected a new password, got %+v", user)
+	}
+	if _, err = client.CloudUserToken(project.ID, user.ID, created.Password); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected the former password to be invalid, got %v", err)
+	}
+	token, err := client.CloudUserToken(project.ID, user.ID, user.Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AuthToken == "" || token.Token.ExpiresAt == "" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+
+	// the credentials of the user authenticate on the OpenStack APIs
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+	server.SetConsoleOutput(project.ID, instance.ID, "web-1 login:\n")
+	creds := user.OpenStackCredentials(project.ID)
+	creds.AuthURL = server.OpenStackAuthURL()
+	if _, err = client.CloudInstanceConsoleOutput(creds, "GRA3", instance.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.CloudDeleteUser(project.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoUser(project.ID, user.ID)
+	checkNotFound(t, err)
+	if err = client.CloudDeleteUser(project.ID, user.ID); err != nil {
+		t.Fatalf("expected deleting a deleted user to succeed, got %v", err)
+	}
+}
+
+func TestCloudUserRoles(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	user := server.AddUser(project.ID, ovh.User{Username: "user-1"})
+
+	roles, err := client.CloudListRoles(project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var operator ovh.Role
+	for _, role := range roles {
+		if role.Name == "compute_operator" {
+			operator = role
+		}
+	}
+	if operator.ID == "" {
+		t.Fatalf("expected a compute_operator role, got %+v", roles)
+	}
+
+	updated, err := client.CloudAddUserRole(project.ID, user.ID, operator.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Roles) != 1 || updated.Roles[0].Name != "compute_operator" {
+		t.Fatalf("unexpected roles %+v", updated.Roles)
+	}
+	if _, err = client.CloudAddUserRole(project.ID, user.ID, "unknown"); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid role, got %v", err)
+	}
+
+	if err = client.CloudRemoveUserRole(project.ID, user.ID, operator.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.CloudRemoveUserRole(project.ID, user.ID, operator.ID); err != nil {
+		t.Fatalf("expected removing a removed role to succeed, got %v", err)
+	}
+	userRoles, err := client.CloudListUserRoles(project.ID, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(userRoles) != 0 {
+		t.Fatalf("expected no roles, got %+v", userRoles)
+	}
+}
+
+func TestOpenrc(t *testing.T) {
+	user := &ovh.User{Username: "user-1", Password: "it's secret"}
+	openrc := user.OpenStackCredentials("project-1").Openrc("GRA3")
+	for _, line := range []string{
+		"export OS_AUTH_URL='https://auth.cloud.ovh.net/v3'\n",
+		"export OS_IDENTITY_API_VERSION=3\n",
+		"export OS_PROJECT_ID='project-1'\n",
+		"export OS_PASSWORD='it'\\''s secret'\n",
+		"export OS_REGION_NAME='GRA3'\n",
+	} {
+		if !strings.Contains(openrc, line) {
+			t.Fatalf("expected %q in openrc, got:\n%s", line, openrc)
+		}
+	}
+
+	user.Password = ""
+	openrc = user.OpenStackCredentials("project-1").Openrc("GRA3")
+	if strings.Contains(openrc, "export OS_PASSWORD=") || !strings.Contains(openrc, "read -r OS_PASSWORD") {
+		t.Fatalf("expected a password prompt, got:\n%s", openrc)
+	}
+}
+
+func TestMergeCloudsYAML(t *testing.T) {
+	creds := (&ovh.User{Username: "user-1", Password: "secret"}).OpenStackCredentials("project-1")
+	existing := []byte("cache:\n  expiration_time: 60\nclouds:\n  home:\n    region_name: RegionOne\n  ovh-GRA3:\n    region_name: GRA1\n")
+
+	data, err := ovh.MergeCloudsYAML(existing, map[string]ovh.CloudsYAMLCloud{
+		"ovh-GRA3": creds.CloudsYAMLCloud("GRA3"),
+		"ovh-SBG5": creds.CloudsYAMLCloud("SBG5"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := struct {
+		Cache  map[string]int                 `json:"cache"`
+		Clouds map[string]ovh.CloudsYAMLCloud `json:"clouds"`
+	}{}
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		t.Fatal(err)
+	}
+	if file.Cache["expiration_time"] != 60 || file.Clouds["home"].RegionName != "RegionOne" {
+		t.Fatalf("expected the other settings to be kept, got:\n%s", data)
+	}
+	if gra := file.Clouds["ovh-GRA3"]; gra.RegionName != "GRA3" || gra.Auth.Password != "secret" || gra.IdentityAPIVersion != 3 {
+		t.Fatalf("expected ovh-GRA3 to be replaced, got:\n%s", data)
+	}
+	if file.Clouds["ovh-SBG5"].Auth.ProjectID != "project-1" {
+		t.Fatalf("expected ovh-SBG5 to be added, got:\n%s", data)
+	}
+
+	data, err = ovh.MergeCloudsYAML(nil, map[string]ovh.CloudsYAMLCloud{"ovh-GRA3": creds.CloudsYAMLCloud("GRA3")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "clouds:\n  ovh-GRA3:\n") {
+		t.Fatalf("unexpected clouds.yaml:\n%s", data)
+	}
+
+	if _, err = ovh.MergeCloudsYAML([]byte("clouds: ["), nil); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid file, got %v", err)
+	}
+}
@@ -0,0 +1,241 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Types of volumes
+const (
+	VolumeClassic   = "classic"
+	VolumeHighSpeed = "high-speed"
+)
+
+// Volume is a go representation of a Cloud block storage volume
+type Volume struct {
+	ID           string   `json:"id,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Region       string   `json:"region,omitempty"`
+	Size         int      `json:"size,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Status       string   `json:"status,omitempty"`
+	Bootable     bool     `json:"bootable,omitempty"`
+	AttachedTo   []string `json:"attachedTo,omitempty"`
+	CreationDate string   `json:"creationDate,omitempty"`
+	PlanCode     string   `json:"planCode,omitempty"`
+}
+
+// VolumeCreateOpts defines the fields for a volume creation
+type VolumeCreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Region      string `json:"region"`
+	// Size in GB
+	Size int `json:"size"`
+	// Type is VolumeClassic or VolumeHighSpeed
+	Type string `json:"type,omitempty"`
+	// ImageID makes a bootable volume with an image
+	ImageID string `json:"imageId,omitempty"`
+	// SnapshotID restores a volume snapshot
+	SnapshotID string `json:"snapshotId,omitempty"`
+}
+
+// VolumeUpdateReq defines the fields for a volume update
+type VolumeUpdateReq struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// VolumeAttachReq defines the fields to attach or detach a volume
+type VolumeAttachReq struct {
+	InstanceID string `json:"instanceId"`
+}
+
+// VolumeUpsizeReq defines the fields for a volume upsize
+type VolumeUpsizeReq struct {
+	Size int `json:"size"`
+}
+
+// VolumeSnapshot is a go representation of a snapshot of a Cloud volume
+type VolumeSnapshot struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	VolumeID     string `json:"volumeId,omitempty"`
+	Region       string `json:"region,omitempty"`
+	Size         int    `json:"size,omitempty"`
+	Status       string `json:"status,omitempty"`
+	CreationDate string `json:"creationDate,omitempty"`
+	PlanCode     string `json:"planCode,omitempty"`
+}
+
+// VolumeSnapshotReq defines the fields for a volume snapshot
+type VolumeSnapshotReq struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// volumePath returns the path of a volume, or of one of its actions if action is set
+func volumePath(projectID, volumeID, action string) string {
+	path := fmt.Sprintf("/cloud/project/%s/volume/%s", url.QueryEscape(projectID), url.QueryEscape(volumeID))
+	if action != "" {
+		path += "/" + action
+	}
+	return path
+}
+
+// CloudListVolumes returns the volumes of region, or of all regions if empty
+func (c *Client) CloudListVolumes(projectID, region string) ([]Volume, error) {
+	return c.CloudListVolumesCtx(context.Background(), projectID, region)
+}
+
+// CloudListVolumesCtx is CloudListVolumes with a context
+func (c *Client) CloudListVolumesCtx(ctx context.Context, projectID, region string) ([]Volume, error) {
+	path := fmt.Sprintf("/cloud/project/%s/volume", url.QueryEscape(projectID))
+	if region != "" {
+		path += "?region=" + url.QueryEscape(region)
+	}
+	volumes := []Volume{}
+	return volumes, c.get(ctx, path, &volumes)
+}
+
+// CloudCreateVolume creates a volume and returns it. The volume is usable
+// once "available", see WaitVolumeStatus.
+func (c *Client) CloudCreateVolume(projectID string, opts VolumeCreateOpts) (*Volume, error) {
+	return c.CloudCreateVolumeCtx(context.Background(), projectID, opts)
+}
+
+// CloudCreateVolumeCtx is CloudCreateVolume with a context
+func (c *Client) CloudCreateVolumeCtx(ctx context.Context, projectID string, opts VolumeCreateOpts) (*Volume, error) {
+	path := fmt.Sprintf("/cloud/project/%s/volume", url.QueryEscape(projectID))
+	volume := &Volume{}
+	return volume, c.post(ctx, path, opts, volume)
+}
+
+// CloudInfoVolume returns a volume
+func (c *Client) CloudInfoVolume(projectID, volumeID string) (*Volume, error) {
+	return c.CloudInfoVolumeCtx(context.Background(), projectID, volumeID)
+}
+
+// CloudInfoVolumeCtx is CloudInfoVolume with a context
+func (c *Client) CloudInfoVolumeCtx(ctx context.Context, projectID, volumeID string) (*Volume, error) {
+	volume := &Volume{}
+	return volume, c.get(ctx, volumePath(projectID, volumeID, ""), volume)
+}
+
+// CloudUpdateVolume renames a volume or changes its description, and returns it
+func (c *Client) CloudUpdateVolume(projectID, volumeID string, req VolumeUpdateReq) (*Volume, error) {
+	return c.CloudUpdateVolumeCtx(context.Background(), projectID, volumeID, req)
+}
+
+// CloudUpdateVolumeCtx is CloudUpdateVolume with a context
+func (c *Client) CloudUpdateVolumeCtx(ctx context.Context, projectID, volumeID string, req VolumeUpdateReq) (*Volume, error) {
+	volume := &Volume{}
+	return volume, c.put(ctx, volumePath(projectID, volumeID, ""), req, volume)
+}
+
+// CloudDeleteVolume deletes a detached volume
+func (c *Client) CloudDeleteVolume(projectID, volumeID string) error {
+	return c.CloudDeleteVolumeCtx(context.Background(), projectID, volumeID)
+}
+
+// CloudDeleteVolumeCtx is CloudDeleteVolume with a context
+func (c *Client) CloudDeleteVolumeCtx(ctx context.Context, projectID, volumeID string) error {
+	err := c.delete(ctx, volumePath(projectID, volumeID, ""), nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
+
+// CloudAttachVolume attaches a volume to an instance of its region, and returns it.
+// The volume is attached once "in-use", see WaitVolumeStatus.
+func (c *Client) CloudAttachVolume(projectID, volumeID, instanceID string) (*Volume, error) {
+	return c.CloudAttachVolumeCtx(context.Background(), projectID, volumeID, instanceID)
+}
+
+// CloudAttachVolumeCtx is CloudAttachVolume with a context
+func (c *Client) CloudAttachVolumeCtx(ctx context.Context, projectID, volumeID, instanceID string) (*Volume, error) {
+	volume := &Volume{}
+	return volume, c.post(ctx, volumePath(projectID, volumeID, "attach"), VolumeAttachReq{InstanceID: instanceID}, volume)
+}
+
+// CloudDetachVolume detaches a volume from an instance, and returns it.
+// The volume is detached once "available", see WaitVolumeStatus.
+func (c *Client) CloudDetachVolume(projectID, volumeID, instanceID string) (*Volume, error) {
+	return c.CloudDetachVolumeCtx(context.Background(), projectID, volumeID, instanceID)
+}
+
+// CloudDetachVolumeCtx is CloudDetachVolume with a context
+func (c *Client) CloudDetachVolumeCtx(ctx context.Context, projectID, volumeID, instanceID string) (*Volume, error) {
+	volume := &Volume{}
+	return volume, c.post(ctx, volumePath(projectID, volumeID, "detach"), VolumeAttachReq{InstanceID: instanceID}, volume)
+}
+
+// CloudUpsizeVolume extends a volume to size GB, and returns it. Volumes
+// cannot shrink. The file system of the volume must be extended afterwards.
+func (c *Client) CloudUpsizeVolume(projectID, volumeID string, size int) (*Volume, error) {
+	return c.CloudUpsizeVolumeCtx(context.Background(), projectID, volumeID, size)
+}
+
+// CloudUpsizeVolumeCtx is CloudUpsizeVolume with a context
+func (c *Client) CloudUpsizeVolumeCtx(ctx context.Context, projectID, volumeID string, size int) (*Volume, error) {
+	volume := &Volume{}
+	return volume, c.post(ctx, volumePath(projectID, volumeID, "upsize"), VolumeUpsizeReq{Size: size}, volume)
+}
+
+// CloudListVolumeSnapshots returns the volume snapshots of region, or of all regions if empty
+func (c *Client) CloudListVolumeSnapshots(projectID, region string) ([]VolumeSnapshot, error) {
+	return c.CloudListVolumeSnapshotsCtx(context.Background(), projectID, region)
+}
+
+// CloudListVolumeSnapshotsCtx is CloudListVolumeSnapshots with a context
+func (c *Client) CloudListVolumeSnapshotsCtx(ctx context.Context, projectID, region string) ([]VolumeSnapshot, error) {
+	path := fmt.Sprintf("/cloud/project/%s/volume/snapshot", url.QueryEscape(projectID))
+	if region != "" {
+		path += "?region=" + url.QueryEscape(region)
+	}
+	snapshots := []VolumeSnapshot{}
+	return snapshots, c.get(ctx, path, &snapshots)
+}
+
+// CloudCreateVolumeSnapshot snapshots a volume, and returns the snapshot
+func (c *Client) CloudCreateVolumeSnapshot(projectID, volumeID string, req VolumeSnapshotReq) (*VolumeSnapshot, error) {
+	return c.CloudCreateVolumeSnapshotCtx(context.Background(), projectID, volumeID, req)
+}
+
+// CloudCreateVolumeSnapshotCtx is CloudCreateVolumeSnapshot with a context
+func (c *Client) CloudCreateVolumeSnapshotCtx(ctx context.Context, projectID, volumeID string, req VolumeSnapshotReq) (*VolumeSnapshot, error) {
+	snapshot := &VolumeSnapshot{}
+	return snapshot, c.post(ctx, volumePath(projectID, volumeID, "snapshot"), req, snapshot)
+}
+
+// CloudInfoVolumeSnapshot returns a volume snapshot
+func (c *Client) CloudInfoVolumeSnapshot(projectID, snapshotID string) (*VolumeSnapshot, error) {
+	return c.CloudInfoVolumeSnapshotCtx(context.Background(), projectID, snapshotID)
+}
+
+// CloudInfoVolumeSnapshotCtx is CloudInfoVolumeSnapshot with a context
+func (c *Client) CloudInfoVolumeSnapshotCtx(ctx context.Context, projectID, snapshotID string) (*VolumeSnapshot, error) {
+	path := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", url.QueryEscape(projectID), url.QueryEscape(snapshotID))
+	snapshot := &VolumeSnapshot{}
+	return snapshot, c.get(ctx, path, snapshot)
+}
+
+// CloudDeleteVolumeSnapshot deletes a volume snapshot
+func (c *Client) CloudDeleteVolumeSnapshot(projectID, snapshotID string) error {
+	return c.CloudDeleteVolumeSnapshotCtx(context.Background(), projectID, snapshotID)
+}
+
+// CloudDeleteVolumeSnapshotCtx is CloudDeleteVolumeSnapshot with a context
+func (c *Client) CloudDeleteVolumeSnapshotCtx(ctx context.Context, projectID, snapshotID string) error {
+	path := fmt.Sprintf("/cloud/project/%s/volume/snapshot/%s", url.QueryEscape(projectID), url.QueryEscape(snapshotID))
+	err := c.delete(ctx, path, nil)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return err
+}
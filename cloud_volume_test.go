@@ -0,0 +1,123 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestCloudVolumes(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "db-1", Region: "GRA3"})
+	other := server.AddInstance(project.ID, ovh.Instance{Name: "db-2", Region: "SBG3"})
+
+	volume, err := client.CloudCreateVolume(project.ID, ovh.VolumeCreateOpts{Name: "data", Region: "GRA3", Size: 50, Type: ovh.VolumeHighSpeed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volume.ID == "" || volume.Status != "creating" || volume.Type != ovh.VolumeHighSpeed {
+		t.Fatalf("unexpected volume %+v", volume)
+	}
+	wait := ovh.WithWaitInterval(time.Millisecond)
+	if volume, err = client.WaitVolumeStatus(context.Background(), project.ID, volume.ID, "available", wait); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.CloudCreateVolume(project.ID, ovh.VolumeCreateOpts{Name: "data", Region: "GRA3", Size: 50, Type: "fast"}); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid volume type, got %v", err)
+	}
+
+	volumes, err := client.CloudListVolumes(project.ID, "GRA3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(volumes) != 1 || volumes[0].Name != "data" {
+		t.Fatalf("unexpected volumes %+v", volumes)
+	}
+
+	if volume, err = client.CloudUpdateVolume(project.ID, volume.ID, ovh.VolumeUpdateReq{Description: "PostgreSQL"}); err != nil {
+		t.Fatal(err)
+	}
+	if volume.Name != "data" || volume.Description != "PostgreSQL" {
+		t.Fatalf("expected the description to change, got %+v", volume)
+	}
+
+	// attach and detach
+	if _, err = client.CloudAttachVolume(project.ID, volume.ID, other.ID); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an error attaching to another region, got %v", err)
+	}
+	if _, err = client.CloudAttachVolume(project.ID, volume.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	if volume, err = client.WaitVolumeStatus(context.Background(), project.ID, volume.ID, "in-use", wait); err != nil {
+		t.Fatal(err)
+	}
+	if len(volume.AttachedTo) != 1 || volume.AttachedTo[0] != instance.ID {
+		t.Fatalf("expected the volume to be attached, got %+v", volume)
+	}
+	if err = client.CloudDeleteVolume(project.ID, volume.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict deleting an attached volume, got %v", err)
+	}
+
+	if volume, err = client.CloudUpsizeVolume(project.ID, volume.ID, 100); err != nil {
+		t.Fatal(err)
+	}
+	if volume.Size != 100 {
+		t.Fatalf("expected the volume to be upsized, got %d GB", volume.Size)
+	}
+	if _, err = client.CloudUpsizeVolume(project.ID, volume.ID, 20); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an error shrinking a volume, got %v", err)
+	}
+
+	if _, err = client.CloudDetachVolume(project.ID, volume.ID, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+	if volume, err = client.WaitVolumeStatus(context.Background(), project.ID, volume.ID, "available", wait); err != nil {
+		t.Fatal(err)
+	}
+
+	// snapshots
+	snapshot, err := client.CloudCreateVolumeSnapshot(project.ID, volume.ID, ovh.VolumeSnapshotReq{Name: "data-nightly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.VolumeID != volume.ID || snapshot.Size != 100 || snapshot.Region != "GRA3" {
+		t.Fatalf("unexpected volume snapshot %+v", snapshot)
+	}
+	snapshots, err := client.CloudListVolumeSnapshots(project.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Name != "data-nightly" {
+		t.Fatalf("unexpected volume snapshots %+v", snapshots)
+	}
+	restored, err := client.CloudCreateVolume(project.ID, ovh.VolumeCreateOpts{Name: "restored", Region: "GRA3", Size: 100, SnapshotID: snapshot.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Type != ovh.VolumeClassic {
+		t.Fatalf("expected a classic volume by default, got %s", restored.Type)
+	}
+	if err = client.CloudDeleteVolumeSnapshot(project.ID, snapshot.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoVolumeSnapshot(project.ID, snapshot.ID)
+	checkNotFound(t, err)
+
+	if err = client.CloudDeleteVolume(project.ID, volume.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CloudInfoVolume(project.ID, volume.ID)
+	checkNotFound(t, err)
+
+	failed := server.AddVolume(project.ID, ovh.Volume{Name: "broken", Region: "GRA3", Size: 10, Status: "error_extending"})
+	_, err = client.WaitVolumeStatus(context.Background(), project.ID, failed.ID, "available", wait)
+	var statusErr *ovh.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Status != "error_extending" {
+		t.Fatalf("expected an error status, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-ini/ini"
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+// Configuration files, by order of increasing priority. They are the same
+// files go-ovh reads its credentials from.
+var (
+	systemConfigPath = "/etc/ovh.conf"
+	userConfigPath   = "/.ovh.conf" // prefixed with homeDir
+	localConfigPath  = "./ovh.conf"
+)
+
+// loadConfig loads every existing configuration file. Missing files are ignored.
+func loadConfig() *ini.File {
+	paths := []interface{}{systemConfigPath}
+	if usr, err := user.Current(); err == nil {
+		paths = append(paths, filepath.Join(usr.HomeDir, userConfigPath))
+	}
+	paths = append(paths, localConfigPath)
+
+	cfg, err := ini.LooseLoad(paths[0], paths[1:]...)
+	if err != nil {
+		return ini.Empty()
+	}
+	return cfg
+}
+
+// getConfigValue returns the value of OVH_<NAME> or name from section, or def
+func getConfigValue(cfg *ini.File, section, name, def string) string {
+	if v := os.Getenv("OVH_" + strings.ToUpper(name)); v != "" {
+		return v
+	}
+	if v := cfg.Section(section).Key(name).String(); v != "" {
+		return v
+	}
+	return def
+}
+
+// resolveEndpoint maps an endpoint name to its URL. Names containing a '/'
+// are considered as URLs.
+func resolveEndpoint(name string) (string, error) {
+	if strings.Contains(name, "/") {
+		return name, nil
+	}
+	if endpoint, ok := govh.Endpoints[name]; ok {
+		return endpoint, nil
+	}
+	return "", fmt.Errorf("Unknown endpoint '%s'. Consider checking 'Endpoints' list of using an URL", name)
+}
+
+// Profile is a named OVH account, read from a "[profile <name>]" section
+// of the configuration files:
+//
+//	[profile staging]
+//	endpoint=ovh-ca
+//	application_key=my_app_key
+//	application_secret=my_application_secret
+//	consumer_key=my_consumer_key
+//
+// Values missing from the profile section are read from the usual
+// environment variables and endpoint sections.
+type Profile struct {
+	Name              string `json:"name"`
+	Endpoint          string `json:"endpoint"`
+	ApplicationKey    string `json:"-"`
+	ApplicationSecret string `json:"-"`
+	ConsumerKey       string `json:"-"`
+}
+
+// ProfileSection returns the name of the configuration section of a profile
+func ProfileSection(name string) string {
+	return profileSectionPrefix + name
+}
+
+const profileSectionPrefix = "profile "
+
+// LoadProfile reads a profile from the configuration files
+func LoadProfile(name string) (*Profile, error) {
+	return loadProfile(loadConfig(), name)
+}
+
+func loadProfile(cfg *ini.File, name string) (*Profile, error) {
+	section, err := cfg.GetSection(ProfileSection(name))
+	if err != nil {
+		return nil, fmt.Errorf("Profile '%s' not found. Add a [%s] section to your ovh.conf file", name, ProfileSection(name))
+	}
+
+	p := &Profile{
+		Name:              name,
+		Endpoint:          section.Key("endpoint").String(),
+		ApplicationKey:    section.Key("application_key").String(),
+		ApplicationSecret: section.Key("application_secret").String(),
+		ConsumerKey:       section.Key("consumer_key").String(),
+	}
+	if p.Endpoint == "" {
+		p.Endpoint = getConfigValue(cfg, "default", "endpoint", "ovh-eu")
+	}
+	return p, nil
+}
+
+// ProfileList returns the profiles defined in the configuration files
+func ProfileList() []Profile {
+	cfg := loadConfig()
+	profiles := []Profile{}
+	for _, name := range cfg.SectionStrings() {
+		if !strings.HasPrefix(name, profileSectionPrefix) {
+			continue
+		}
+		if p, err := loadProfile(cfg, strings.TrimPrefix(name, profileSectionPrefix)); err == nil {
+			profiles = append(profiles, *p)
+		}
+	}
+	return profiles
+}
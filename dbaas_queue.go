@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -80,8 +81,13 @@ type DBaasQueueServiceInfo struct {
 
 // DBaasQueueAppList list all your app
 func (c *Client) DBaasQueueAppList(withDetails bool) ([]DBaasQueueApp, error) {
+	return c.DBaasQueueAppListCtx(context.Background(), withDetails)
+}
+
+// DBaasQueueAppListCtx is DBaasQueueAppList with a context
+func (c *Client) DBaasQueueAppListCtx(ctx context.Context, withDetails bool) ([]DBaasQueueApp, error) {
 	var ids []string
-	if err := c.OVHClient.Get("/dbaas/queue", &ids); err != nil {
+	if err := c.get(ctx, "/dbaas/queue", &ids); err != nil {
 		return nil, err
 	}
 
@@ -94,48 +100,43 @@ func (c *Client) DBaasQueueAppList(withDetails bool) ([]DBaasQueueApp, error) {
 		return apps, nil
 	}
 
-	appsChan, errChan := make(chan DBaasQueueApp), make(chan error)
-	for _, app := range apps {
-		go func(app DBaasQueueApp) {
-			d, err := c.DBaasQueueAppInfo(app.ID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			appsChan <- *d
-		}(app)
-	}
-
-	appsComplete := []DBaasQueueApp{}
-	for i := 0; i < len(apps); i++ {
-		select {
-		case apps := <-appsChan:
-			appsComplete = append(appsComplete, apps)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return appsComplete, nil
+	return FetchDetails(ctx, c, apps, func(ctx context.Context, app DBaasQueueApp) (*DBaasQueueApp, error) {
+		return c.DBaasQueueAppInfoCtx(ctx, app.ID)
+	})
 }
 
 // DBaasQueueAppInfo retrieve all infos of one of your apps
 func (c *Client) DBaasQueueAppInfo(serviceName string) (*DBaasQueueApp, error) {
+	return c.DBaasQueueAppInfoCtx(context.Background(), serviceName)
+}
+
+// DBaasQueueAppInfoCtx is DBaasQueueAppInfo with a context
+func (c *Client) DBaasQueueAppInfoCtx(ctx context.Context, serviceName string) (*DBaasQueueApp, error) {
 	app := &DBaasQueueApp{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s", url.QueryEscape(serviceName)), app)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s", url.QueryEscape(serviceName)), app)
 	return app, err
 }
 
 // DBaasQueueAppServiceInfo retrieve all infos of one of your apps
 func (c *Client) DBaasQueueAppServiceInfo(serviceName string) (*DBaasQueueServiceInfo, error) {
+	return c.DBaasQueueAppServiceInfoCtx(context.Background(), serviceName)
+}
+
+// DBaasQueueAppServiceInfoCtx is DBaasQueueAppServiceInfo with a context
+func (c *Client) DBaasQueueAppServiceInfoCtx(ctx context.Context, serviceName string) (*DBaasQueueServiceInfo, error) {
 	app := &DBaasQueueServiceInfo{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/serviceInfos", url.QueryEscape(serviceName)), app)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/serviceInfos", url.QueryEscape(serviceName)), app)
 	return app, err
 }
 
 // DBaasQueueAppInfoByName retrieve all infos of one of your apps
 func (c *Client) DBaasQueueAppInfoByName(name string) (*DBaasQueueApp, error) {
-	apps, err := c.DBaasQueueAppList(true)
+	return c.DBaasQueueAppInfoByNameCtx(context.Background(), name)
+}
+
+// DBaasQueueAppInfoByNameCtx is DBaasQueueAppInfoByName with a context
+func (c *Client) DBaasQueueAppInfoByNameCtx(ctx context.Context, name string) (*DBaasQueueApp, error) {
+	apps, err := c.DBaasQueueAppListCtx(ctx, true)
 	if err != nil {
 		return nil, err
 	}
@@ -150,8 +151,13 @@ func (c *Client) DBaasQueueAppInfoByName(name string) (*DBaasQueueApp, error) {
 
 // DBaasQueueKeyList list all key on a service
 func (c *Client) DBaasQueueKeyList(serviceName string, withDetails bool) ([]DBaasQueueKey, error) {
+	return c.DBaasQueueKeyListCtx(context.Background(), serviceName, withDetails)
+}
+
+// DBaasQueueKeyListCtx is DBaasQueueKeyList with a context
+func (c *Client) DBaasQueueKeyListCtx(ctx context.Context, serviceName string, withDetails bool) ([]DBaasQueueKey, error) {
 	var ids []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/key", url.QueryEscape(serviceName)), &ids); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/key", url.QueryEscape(serviceName)), &ids); err != nil {
 		return nil, err
 	}
 
@@ -164,42 +170,32 @@ func (c *Client) DBaasQueueKeyList(serviceName string, withDetails bool) ([]DBaa
 		return keys, nil
 	}
 
-	keysChan, errChan := make(chan DBaasQueueKey), make(chan error)
-	for _, key := range keys {
-		go func(key DBaasQueueKey) {
-			d, err := c.DBaasQueueKeyInfo(serviceName, key.ID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			keysChan <- *d
-		}(key)
-	}
-
-	keysComplete := []DBaasQueueKey{}
-	for i := 0; i < len(keys); i++ {
-		select {
-		case keys := <-keysChan:
-			keysComplete = append(keysComplete, keys)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return keysComplete, nil
+	return FetchDetails(ctx, c, keys, func(ctx context.Context, key DBaasQueueKey) (*DBaasQueueKey, error) {
+		return c.DBaasQueueKeyInfoCtx(ctx, serviceName, key.ID)
+	})
 }
 
 // DBaasQueueKeyInfo retrieves all infos of one of your apps
 func (c *Client) DBaasQueueKeyInfo(serviceName, keyID string) (*DBaasQueueKey, error) {
+	return c.DBaasQueueKeyInfoCtx(context.Background(), serviceName, keyID)
+}
+
+// DBaasQueueKeyInfoCtx is DBaasQueueKeyInfo with a context
+func (c *Client) DBaasQueueKeyInfoCtx(ctx context.Context, serviceName, keyID string) (*DBaasQueueKey, error) {
 	key := &DBaasQueueKey{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/key/%s", url.QueryEscape(serviceName), url.QueryEscape(keyID)), key)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/key/%s", url.QueryEscape(serviceName), url.QueryEscape(keyID)), key)
 	return key, err
 }
 
 // DBaasQueueRoleList list all roles on a service
 func (c *Client) DBaasQueueRoleList(serviceName string, withDetails bool) ([]DBaasQueueRole, error) {
+	return c.DBaasQueueRoleListCtx(context.Background(), serviceName, withDetails)
+}
+
+// DBaasQueueRoleListCtx is DBaasQueueRoleList with a context
+func (c *Client) DBaasQueueRoleListCtx(ctx context.Context, serviceName string, withDetails bool) ([]DBaasQueueRole, error) {
 	var ids []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/role", url.QueryEscape(serviceName)), &ids); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/role", url.QueryEscape(serviceName)), &ids); err != nil {
 		return nil, err
 	}
 
@@ -212,42 +208,32 @@ func (c *Client) DBaasQueueRoleList(serviceName string, withDetails bool) ([]DBa
 		return roles, nil
 	}
 
-	rolesChan, errChan := make(chan DBaasQueueRole), make(chan error)
-	for _, role := range roles {
-		go func(role DBaasQueueRole) {
-			d, err := c.DBaasQueueRoleInfo(serviceName, role.Name)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			rolesChan <- *d
-		}(role)
-	}
-
-	rolesComplete := []DBaasQueueRole{}
-	for i := 0; i < len(roles); i++ {
-		select {
-		case roles := <-rolesChan:
-			rolesComplete = append(rolesComplete, roles)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return rolesComplete, nil
+	return FetchDetails(ctx, c, roles, func(ctx context.Context, role DBaasQueueRole) (*DBaasQueueRole, error) {
+		return c.DBaasQueueRoleInfoCtx(ctx, serviceName, role.Name)
+	})
 }
 
 // DBaasQueueRoleInfo  retrieves all infos of one role on a service
 func (c *Client) DBaasQueueRoleInfo(serviceName, roleID string) (*DBaasQueueRole, error) {
+	return c.DBaasQueueRoleInfoCtx(context.Background(), serviceName, roleID)
+}
+
+// DBaasQueueRoleInfoCtx is DBaasQueueRoleInfo with a context
+func (c *Client) DBaasQueueRoleInfoCtx(ctx context.Context, serviceName, roleID string) (*DBaasQueueRole, error) {
 	role := &DBaasQueueRole{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/role/%s", url.QueryEscape(serviceName), url.QueryEscape(roleID)), role)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/role/%s", url.QueryEscape(serviceName), url.QueryEscape(roleID)), role)
 	return role, err
 }
 
 // DBaasQueueRegionList list all region on a service
 func (c *Client) DBaasQueueRegionList(serviceName string, withDetails bool) ([]DBaasQueueRegion, error) {
+	return c.DBaasQueueRegionListCtx(context.Background(), serviceName, withDetails)
+}
+
+// DBaasQueueRegionListCtx is DBaasQueueRegionList with a context
+func (c *Client) DBaasQueueRegionListCtx(ctx context.Context, serviceName string, withDetails bool) ([]DBaasQueueRegion, error) {
 	var ids []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/region", url.QueryEscape(serviceName)), &ids); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/region", url.QueryEscape(serviceName)), &ids); err != nil {
 		return nil, err
 	}
 
@@ -260,42 +246,32 @@ func (c *Client) DBaasQueueRegionList(serviceName string, withDetails bool) ([]D
 		return regions, nil
 	}
 
-	regionsChan, errChan := make(chan DBaasQueueRegion), make(chan error)
-	for _, region := range regions {
-		go func(region DBaasQueueRegion) {
-			d, err := c.DBaasQueueRegionInfo(serviceName, region.Name)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			regionsChan <- *d
-		}(region)
-	}
-
-	regionsComplete := []DBaasQueueRegion{}
-	for i := 0; i < len(regions); i++ {
-		select {
-		case regions := <-regionsChan:
-			regionsComplete = append(regionsComplete, regions)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return regionsComplete, nil
+	return FetchDetails(ctx, c, regions, func(ctx context.Context, region DBaasQueueRegion) (*DBaasQueueRegion, error) {
+		return c.DBaasQueueRegionInfoCtx(ctx, serviceName, region.Name)
+	})
 }
 
 // DBaasQueueRegionInfo retrieves all infos of one region on a service
 func (c *Client) DBaasQueueRegionInfo(serviceName, regionID string) (*DBaasQueueRegion, error) {
+	return c.DBaasQueueRegionInfoCtx(context.Background(), serviceName, regionID)
+}
+
+// DBaasQueueRegionInfoCtx is DBaasQueueRegionInfo with a context
+func (c *Client) DBaasQueueRegionInfoCtx(ctx context.Context, serviceName, regionID string) (*DBaasQueueRegion, error) {
 	region := &DBaasQueueRegion{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/region/%s", url.QueryEscape(serviceName), url.QueryEscape(regionID)), region)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/region/%s", url.QueryEscape(serviceName), url.QueryEscape(regionID)), region)
 	return region, err
 }
 
 // DBaasQueueTopicList list all topics on a service
 func (c *Client) DBaasQueueTopicList(serviceName string, withDetails bool) ([]DBaasQueueTopic, error) {
+	return c.DBaasQueueTopicListCtx(context.Background(), serviceName, withDetails)
+}
+
+// DBaasQueueTopicListCtx is DBaasQueueTopicList with a context
+func (c *Client) DBaasQueueTopicListCtx(ctx context.Context, serviceName string, withDetails bool) ([]DBaasQueueTopic, error) {
 	var ids []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/topic", url.QueryEscape(serviceName)), &ids); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/topic", url.QueryEscape(serviceName)), &ids); err != nil {
 		return nil, err
 	}
 
@@ -308,42 +284,32 @@ func (c *Client) DBaasQueueTopicList(serviceName string, withDetails bool) ([]DB
 		return topics, nil
 	}
 
-	topicsChan, errChan := make(chan DBaasQueueTopic), make(chan error)
-	for _, topic := range topics {
-		go func(topic DBaasQueueTopic) {
-			d, err := c.DBaasQueueTopicInfo(serviceName, topic.ID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			topicsChan <- *d
-		}(topic)
-	}
-
-	topicsComplete := []DBaasQueueTopic{}
-	for i := 0; i < len(topics); i++ {
-		select {
-		case topics := <-topicsChan:
-			topicsComplete = append(topicsComplete, topics)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return topicsComplete, nil
+	return FetchDetails(ctx, c, topics, func(ctx context.Context, topic DBaasQueueTopic) (*DBaasQueueTopic, error) {
+		return c.DBaasQueueTopicInfoCtx(ctx, serviceName, topic.ID)
+	})
 }
 
 // DBaasQueueTopicInfo retrieves all infos of one topic on a service
 func (c *Client) DBaasQueueTopicInfo(serviceName, topicID string) (*DBaasQueueTopic, error) {
+	return c.DBaasQueueTopicInfoCtx(context.Background(), serviceName, topicID)
+}
+
+// DBaasQueueTopicInfoCtx is DBaasQueueTopicInfo with a context
+func (c *Client) DBaasQueueTopicInfoCtx(ctx context.Context, serviceName, topicID string) (*DBaasQueueTopic, error) {
 	topic := &DBaasQueueTopic{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/topic/%s", url.QueryEscape(serviceName), url.QueryEscape(topicID)), topic)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/topic/%s", url.QueryEscape(serviceName), url.QueryEscape(topicID)), topic)
 	return topic, err
 }
 
 // DBaasQueueUserList list all users on a service
 func (c *Client) DBaasQueueUserList(serviceName string, withDetails bool) ([]DBaasQueueUser, error) {
+	return c.DBaasQueueUserListCtx(context.Background(), serviceName, withDetails)
+}
+
+// DBaasQueueUserListCtx is DBaasQueueUserList with a context
+func (c *Client) DBaasQueueUserListCtx(ctx context.Context, serviceName string, withDetails bool) ([]DBaasQueueUser, error) {
 	var ids []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/user", url.QueryEscape(serviceName)), &ids); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/user", url.QueryEscape(serviceName)), &ids); err != nil {
 		return nil, err
 	}
 
@@ -356,48 +322,43 @@ func (c *Client) DBaasQueueUserList(serviceName string, withDetails bool) ([]DBa
 		return users, nil
 	}
 
-	usersChan, errChan := make(chan DBaasQueueUser), make(chan error)
-	for _, user := range users {
-		go func(user DBaasQueueUser) {
-			d, err := c.DBaasQueueUserInfo(serviceName, user.ID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			usersChan <- *d
-		}(user)
-	}
-
-	usersComplete := []DBaasQueueUser{}
-	for i := 0; i < len(users); i++ {
-		select {
-		case users := <-usersChan:
-			usersComplete = append(usersComplete, users)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return usersComplete, nil
+	return FetchDetails(ctx, c, users, func(ctx context.Context, user DBaasQueueUser) (*DBaasQueueUser, error) {
+		return c.DBaasQueueUserInfoCtx(ctx, serviceName, user.ID)
+	})
 }
 
 // DBaasQueueUserInfo retrieve all infos of one user of your apps
 func (c *Client) DBaasQueueUserInfo(serviceName, userID string) (*DBaasQueueUser, error) {
+	return c.DBaasQueueUserInfoCtx(context.Background(), serviceName, userID)
+}
+
+// DBaasQueueUserInfoCtx is DBaasQueueUserInfo with a context
+func (c *Client) DBaasQueueUserInfoCtx(ctx context.Context, serviceName, userID string) (*DBaasQueueUser, error) {
 	user := &DBaasQueueUser{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/user/%s", url.QueryEscape(serviceName), url.QueryEscape(userID)), user)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/user/%s", url.QueryEscape(serviceName), url.QueryEscape(userID)), user)
 	return user, err
 }
 
 // DBaasQueueUserChangePassword reset user password
 func (c *Client) DBaasQueueUserChangePassword(serviceName, userID string) (*DBaasQueueUser, error) {
+	return c.DBaasQueueUserChangePasswordCtx(context.Background(), serviceName, userID)
+}
+
+// DBaasQueueUserChangePasswordCtx is DBaasQueueUserChangePassword with a context
+func (c *Client) DBaasQueueUserChangePasswordCtx(ctx context.Context, serviceName, userID string) (*DBaasQueueUser, error) {
 	user := &DBaasQueueUser{}
-	err := c.OVHClient.Post(fmt.Sprintf("/dbaas/queue/%s/user/%s/changePassword", url.QueryEscape(serviceName), url.QueryEscape(userID)), nil, user)
+	err := c.post(ctx, fmt.Sprintf("/dbaas/queue/%s/user/%s/changePassword", url.QueryEscape(serviceName), url.QueryEscape(userID)), nil, user)
 	return user, err
 }
 
 // DBaasQueueMetricsAccount retrieve all infos of one of your apps
 func (c *Client) DBaasQueueMetricsAccount(serviceName string) (*DBaasQueueMetricsAccount, error) {
+	return c.DBaasQueueMetricsAccountCtx(context.Background(), serviceName)
+}
+
+// DBaasQueueMetricsAccountCtx is DBaasQueueMetricsAccount with a context
+func (c *Client) DBaasQueueMetricsAccountCtx(ctx context.Context, serviceName string) (*DBaasQueueMetricsAccount, error) {
 	user := &DBaasQueueMetricsAccount{}
-	err := c.OVHClient.Get(fmt.Sprintf("/dbaas/queue/%s/metrics/account", url.QueryEscape(serviceName)), user)
+	err := c.get(ctx, fmt.Sprintf("/dbaas/queue/%s/metrics/account", url.QueryEscape(serviceName)), user)
 	return user, err
 }
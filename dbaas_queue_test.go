@@ -0,0 +1,145 @@
+package ovh_test
+
+import (
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestDBaasQueueApps(t *testing.T) {
+	server, client := newTestServer(t)
+	app := server.AddQueueApp(ovh.DBaasQueueApp{Name: "events", HumanID: "events-1"})
+	server.AddQueueApp(ovh.DBaasQueueApp{Name: "logs"})
+	server.SetQueueServiceInfo(app.ID, ovh.DBaasQueueServiceInfo{Domain: app.ID, Status: "ok"})
+	server.SetQueueMetricsAccount(app.ID, ovh.DBaasQueueMetricsAccount{Host: "opentsdb.example.com", Token: "t0k3n"})
+
+	apps, err := client.DBaasQueueAppList(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 2 || apps[0].ID != app.ID || apps[0].Name != "" {
+		t.Fatalf("unexpected apps %+v", apps)
+	}
+
+	apps, err = client.DBaasQueueAppList(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 2 || apps[0].Name != "events" || apps[1].Name != "logs" {
+		t.Fatalf("unexpected apps with details %+v", apps)
+	}
+
+	info, err := client.DBaasQueueAppInfo(app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.HumanID != "events-1" || info.AppStatus != "active" {
+		t.Fatalf("unexpected app %+v", info)
+	}
+
+	info, err = client.DBaasQueueAppInfoByName("events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ID != app.ID {
+		t.Fatalf("unexpected app %+v", info)
+	}
+	if _, err = client.DBaasQueueAppInfoByName("unknown"); err == nil {
+		t.Fatal("expected an error for an unknown app name")
+	}
+
+	serviceInfo, err := client.DBaasQueueAppServiceInfo(app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serviceInfo.Status != "ok" {
+		t.Fatalf("unexpected service info %+v", serviceInfo)
+	}
+
+	metrics, err := client.DBaasQueueMetricsAccount(app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Token != "t0k3n" {
+		t.Fatalf("unexpected metrics account %+v", metrics)
+	}
+
+	_, err = client.DBaasQueueAppInfo("unknown")
+	checkNotFound(t, err)
+}
+
+func TestDBaasQueueResources(t *testing.T) {
+	server, client := newTestServer(t)
+	app := server.AddQueueApp(ovh.DBaasQueueApp{Name: "events"})
+	key := server.AddQueueKey(app.ID, ovh.DBaasQueueKey{Name: "producer"})
+	server.AddQueueRole(app.ID, ovh.DBaasQueueRole{Name: "reader", ReadACL: []string{"events.*"}})
+	region := server.AddQueueRegion(app.ID, ovh.DBaasQueueRegion{Name: "GRA", URL: "kafka.gra.example.com"})
+	server.AddQueueTopic(app.ID, ovh.DBaasQueueTopic{ID: "events.clicks", Partitions: 3, ReplicationFactor: 2})
+	user := server.AddQueueUser(app.ID, ovh.DBaasQueueUser{Name: "consumer", Roles: []string{"reader"}})
+
+	keys, err := client.DBaasQueueKeyList(app.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].Name != "producer" {
+		t.Fatalf("unexpected keys %+v", keys)
+	}
+	if k, err := client.DBaasQueueKeyInfo(app.ID, key.ID); err != nil || k.Name != "producer" {
+		t.Fatalf("unexpected key %+v, %v", k, err)
+	}
+
+	roles, err := client.DBaasQueueRoleList(app.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 1 || roles[0].Name != "reader" || len(roles[0].ReadACL) != 1 {
+		t.Fatalf("unexpected roles %+v", roles)
+	}
+	if r, err := client.DBaasQueueRoleInfo(app.ID, "reader"); err != nil || r.ReadACL[0] != "events.*" {
+		t.Fatalf("unexpected role %+v, %v", r, err)
+	}
+
+	regions, err := client.DBaasQueueRegionList(app.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != 1 || regions[0].Name != "GRA" {
+		t.Fatalf("unexpected regions %+v", regions)
+	}
+	if r, err := client.DBaasQueueRegionInfo(app.ID, region.ID); err != nil || r.URL != "kafka.gra.example.com" {
+		t.Fatalf("unexpected region %+v, %v", r, err)
+	}
+
+	topics, err := client.DBaasQueueTopicList(app.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != 1 || topics[0].Partitions != 3 {
+		t.Fatalf("unexpected topics %+v", topics)
+	}
+	if topic, err := client.DBaasQueueTopicInfo(app.ID, "events.clicks"); err != nil || topic.ReplicationFactor != 2 {
+		t.Fatalf("unexpected topic %+v, %v", topic, err)
+	}
+
+	users, err := client.DBaasQueueUserList(app.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].ID != user.ID || users[0].Name != "" {
+		t.Fatalf("unexpected users %+v", users)
+	}
+	if u, err := client.DBaasQueueUserInfo(app.ID, user.ID); err != nil || u.Name != "consumer" {
+		t.Fatalf("unexpected user %+v, %v", u, err)
+	}
+
+	changed, err := client.DBaasQueueUserChangePassword(app.ID, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed.Password == "" {
+		t.Fatalf("expected a new password, got %+v", changed)
+	}
+
+	_, err = client.DBaasQueueUserInfo(app.ID, "unknown")
+	checkNotFound(t, err)
+}
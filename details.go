@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultConcurrency is the number of concurrent requests used to fetch the
+// details of listed items, when not set with WithConcurrency
+const DefaultConcurrency = 10
+
+// ItemError is the error that occurred fetching the details of one item
+type ItemError struct {
+	// Index of the item in the list
+	Index int
+	Err   error
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("item %d: %s", e.Index, e.Err)
+}
+
+// DetailsError is returned in partial results mode when the details of some
+// items could not be fetched. The other items are returned along with it.
+type DetailsError struct {
+	Errors []ItemError
+}
+
+func (e *DetailsError) Error() string {
+	return fmt.Sprintf("%d item(s) could not be fetched, first error: %s", len(e.Errors), e.Errors[0].Err)
+}
+
+// Unwrap returns the first error, to test its category with errors.Is
+func (e *DetailsError) Unwrap() error {
+	return e.Errors[0].Err
+}
+
+// FetchDetails calls fetch for every item with at most the client's
+// concurrency of calls running at once, and returns the results in the order
+// of items.
+//
+// A fetch returning neither details nor error fails for its item with an
+// error of category ErrInvalid. By default, the first error cancels the
+// remaining calls and is returned.
+// When the client is built WithPartialResults, every item is fetched and the
+// ones that failed are left out of the results, and reported in a *DetailsError.
+func FetchDetails[T any](ctx context.Context, c *Client, items []T, fetch func(context.Context, T) (*T, error)) ([]T, error) {
+	workers := c.concurrency
+	if workers <= 0 {
+		workers = DefaultConcurrency
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
+		results  = make([]*T, len(items))
+		errs     = make([]error, len(items))
+		indexes  = make(chan int)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if fetchCtx.Err() != nil {
+					continue
+				}
+				d, err := fetch(fetchCtx, items[i])
+				if err == nil && d == nil {
+					err = fmt.Errorf("No details returned: %w", ErrInvalid)
+				}
+				if err == nil {
+					results[i] = d
+					continue
+				}
+				errs[i] = err
+				if !c.partialResults {
+					mutex.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mutex.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range items {
+		select {
+		case indexes <- i:
+		case <-fetchCtx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	complete := make([]T, 0, len(items))
+	detailsErr := &DetailsError{}
+	for i := range items {
+		if errs[i] != nil {
+			detailsErr.Errors = append(detailsErr.Errors, ItemError{Index: i, Err: errs[i]})
+			continue
+		}
+		complete = append(complete, *results[i])
+	}
+
+	if len(detailsErr.Errors) > 0 {
+		return complete, detailsErr
+	}
+	return complete, nil
+}
@@ -0,0 +1,111 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchDetailsOrderAndConcurrency(t *testing.T) {
+	c := &Client{concurrency: 3}
+
+	items := []int{}
+	for i := 0; i < 30; i++ {
+		items = append(items, i)
+	}
+
+	var running, maxRunning int32
+	res, err := FetchDetails(context.Background(), c, items, func(ctx context.Context, i int) (*int, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		d := i * 10
+		return &d, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if maxRunning > 3 {
+		t.Fatalf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+	for i, d := range res {
+		if d != i*10 {
+			t.Fatalf("expected result %d to be %d, got %d", i, i*10, d)
+		}
+	}
+}
+
+func TestFetchDetailsFirstError(t *testing.T) {
+	c := &Client{concurrency: 2}
+	boom := errors.New("boom")
+	goroutines := runtime.NumGoroutine()
+
+	var calls int32
+	_, err := FetchDetails(context.Background(), c, make([]int, 100), func(ctx context.Context, i int) (*int, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, boom
+	})
+	if err != boom {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if calls > 3 {
+		t.Fatalf("expected remaining calls to be cancelled, got %d calls", calls)
+	}
+	if n := runtime.NumGoroutine(); n > goroutines {
+		t.Fatalf("leaked %d goroutine(s)", n-goroutines)
+	}
+}
+
+func TestFetchDetailsPartialResults(t *testing.T) {
+	c := &Client{partialResults: true}
+
+	res, err := FetchDetails(context.Background(), c, []int{0, 1, 2, 3}, func(ctx context.Context, i int) (*int, error) {
+		if i%2 == 1 {
+			return nil, errors.New("odd")
+		}
+		return &i, nil
+	})
+
+	detailsErr, ok := err.(*DetailsError)
+	if !ok {
+		t.Fatalf("expected a *DetailsError, got %v", err)
+	}
+	if len(detailsErr.Errors) != 2 || detailsErr.Errors[0].Index != 1 || detailsErr.Errors[1].Index != 3 {
+		t.Fatalf("unexpected item errors %v", detailsErr.Errors)
+	}
+	if len(res) != 2 || res[0] != 0 || res[1] != 2 {
+		t.Fatalf("unexpected results %v", res)
+	}
+}
+
+func TestFetchDetailsNilResult(t *testing.T) {
+	fetch := func(ctx context.Context, i int) (*int, error) {
+		if i == 1 {
+			return nil, nil
+		}
+		return &i, nil
+	}
+
+	if _, err := FetchDetails(context.Background(), &Client{}, []int{0, 1, 2}, fetch); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("expected an invalid result, got %v", err)
+	}
+
+	res, err := FetchDetails(context.Background(), &Client{partialResults: true}, []int{0, 1, 2}, fetch)
+	detailsErr, ok := err.(*DetailsError)
+	if !ok || len(detailsErr.Errors) != 1 || detailsErr.Errors[0].Index != 1 || !errors.Is(err, ErrInvalid) {
+		t.Fatalf("expected item 1 to be invalid, got %v", err)
+	}
+	if len(res) != 2 || res[0] != 0 || res[1] != 2 {
+		t.Fatalf("unexpected results %v", res)
+	}
+}
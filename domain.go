@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -47,8 +48,13 @@ type Domain struct {
 
 // DomainList list all your domain
 func (c *Client) DomainList(withDetails bool) ([]Domain, error) {
+	return c.DomainListCtx(context.Background(), withDetails)
+}
+
+// DomainListCtx is DomainList with a context
+func (c *Client) DomainListCtx(ctx context.Context, withDetails bool) ([]Domain, error) {
 	var names []string
-	if err := c.OVHClient.Get("/domain", &names); err != nil {
+	if err := c.get(ctx, "/domain", &names); err != nil {
 		return nil, err
 	}
 
@@ -61,35 +67,19 @@ func (c *Client) DomainList(withDetails bool) ([]Domain, error) {
 		return domains, nil
 	}
 
-	domainsChan, errChan := make(chan Domain), make(chan error)
-	for _, domain := range domains {
-		go func(domain Domain) {
-			d, err := c.DomainInfo(domain.Domain)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			domainsChan <- *d
-		}(domain)
-	}
-
-	domainsComplete := []Domain{}
-
-	for i := 0; i < len(domains); i++ {
-		select {
-		case domains := <-domainsChan:
-			domainsComplete = append(domainsComplete, domains)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return domainsComplete, nil
+	return FetchDetails(ctx, c, domains, func(ctx context.Context, domain Domain) (*Domain, error) {
+		return c.DomainInfoCtx(ctx, domain.Domain)
+	})
 }
 
 // DomainInfo retrieve all infos of one of your domains
 func (c *Client) DomainInfo(domainName string) (*Domain, error) {
+	return c.DomainInfoCtx(context.Background(), domainName)
+}
+
+// DomainInfoCtx is DomainInfo with a context
+func (c *Client) DomainInfoCtx(ctx context.Context, domainName string) (*Domain, error) {
 	domain := &Domain{}
-	err := c.OVHClient.Get(fmt.Sprintf("/domain/%s", url.QueryEscape(domainName)), domain)
+	err := c.get(ctx, fmt.Sprintf("/domain/%s", url.QueryEscape(domainName)), domain)
 	return domain, err
 }
@@ -0,0 +1,40 @@
+package ovh_test
+
+import (
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestDomains(t *testing.T) {
+	server, client := newTestServer(t)
+	server.AddDomain(ovh.Domain{Domain: "example.com", Offer: "gold", NameServerType: "hosted"})
+	server.AddDomain(ovh.Domain{Domain: "example.org", Offer: "diamond"})
+
+	domains, err := client.DomainList(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(domains) != 2 || domains[0].Domain != "example.com" || domains[0].Offer != "" {
+		t.Fatalf("unexpected domains %+v", domains)
+	}
+
+	domains, err = client.DomainList(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(domains) != 2 || domains[0].Offer != "gold" || domains[1].Offer != "diamond" {
+		t.Fatalf("unexpected domains with details %+v", domains)
+	}
+
+	domain, err := client.DomainInfo("example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.NameServerType != "hosted" {
+		t.Fatalf("unexpected domain %+v", domain)
+	}
+
+	_, err = client.DomainInfo("example.net")
+	checkNotFound(t, err)
+}
@@ -0,0 +1,101 @@
+package ovh
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+// Categories of the errors returned by the API, to test with errors.Is:
+//
+//	if errors.Is(err, ovh.ErrNotFound) { ... }
+var (
+	// ErrNotFound is the category of requests on missing resources
+	ErrNotFound = errors.New("not found")
+	// ErrAuth is the category of requests with invalid or expired keys
+	ErrAuth = errors.New("authentication failed")
+	// ErrForbidden is the category of requests not allowed to the account
+	ErrForbidden = errors.New("forbidden")
+	// ErrQuotaExceeded is the category of requests exceeding a quota
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrInvalid is the category of invalid requests
+	ErrInvalid = errors.New("invalid request")
+	// ErrConflict is the category of requests conflicting with the state of a resource
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited is the category of requests rejected by a rate limit
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServer is the category of server errors
+	ErrServer = errors.New("server error")
+)
+
+// authErrorCodes are the error codes of the API for invalid or expired keys
+var authErrorCodes = []string{"INVALID_KEY", "INVALID_CREDENTIAL", "NOT_CREDENTIAL", "INVALID_SIGNATURE", "NOT_GRANTED_CALL", "QUERY_TIME_OUT"}
+
+// Error is an error returned by the API. It wraps the *govh.APIError with the
+// HTTP status, message and query ID, and adds the OVH error class and code.
+// Its category is matched by errors.Is, see ErrNotFound and the other ones.
+//
+// API errors used to be returned as *govh.APIError: type assertions on it no
+// longer match, use errors.As, which finds the wrapped *govh.APIError:
+//
+//	var apiErr *govh.APIError
+//	if errors.As(err, &apiErr) { ... }
+type Error struct {
+	*govh.APIError
+
+	// Class is the OVH error class, such as "Client::NotFound"
+	Class string
+	// ErrorCode is the OVH error code of authentication errors, such as "INVALID_CREDENTIAL"
+	ErrorCode string
+
+	// Method and Path of the failed request
+	Method string
+	Path   string
+}
+
+func (e *Error) Error() string {
+	return e.APIError.Error()
+}
+
+// Unwrap returns the wrapped *govh.APIError, for errors.As
+func (e *Error) Unwrap() error {
+	return e.APIError
+}
+
+// Is tells if target is the category of e
+func (e *Error) Is(target error) bool {
+	return target == e.Category()
+}
+
+// Category returns the category of e: ErrNotFound, ErrAuth, ErrForbidden,
+// ErrQuotaExceeded, ErrInvalid, ErrConflict, ErrRateLimited or ErrServer
+func (e *Error) Category() error {
+	for _, code := range authErrorCodes {
+		if e.ErrorCode == code {
+			return ErrAuth
+		}
+	}
+	// the API classifies quota errors as Client::Forbidden::QuotaExceeded,
+	// and OpenStack relays them as 413 Request Entity Too Large
+	if strings.HasSuffix(e.Class, "::QuotaExceeded") || e.Code == http.StatusRequestEntityTooLarge {
+		return ErrQuotaExceeded
+	}
+
+	switch {
+	case e.Code == http.StatusUnauthorized:
+		return ErrAuth
+	case e.Code == http.StatusNotFound:
+		return ErrNotFound
+	case e.Code == http.StatusForbidden:
+		return ErrForbidden
+	case e.Code == http.StatusConflict:
+		return ErrConflict
+	case e.Code == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.Code >= http.StatusInternalServerError:
+		return ErrServer
+	}
+	return ErrInvalid
+}
@@ -0,0 +1,72 @@
+package ovh_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhtest"
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+func TestErrors(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+
+	_, err := client.CloudInfoInstance(project.ID, "missing")
+	var apiErr *ovh.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *ovh.Error, got %T", err)
+	}
+	if apiErr.Code != http.StatusNotFound || apiErr.Class != "Client::NotFound" || apiErr.QueryID == "" || apiErr.Method != "GET" || apiErr.Path != "/cloud/project/"+project.ID+"/instance/missing" {
+		t.Fatalf("unexpected error %+v", apiErr)
+	}
+	if !errors.Is(err, ovh.ErrNotFound) || errors.Is(err, ovh.ErrAuth) {
+		t.Fatalf("expected a not found error, got %v", apiErr.Category())
+	}
+	// callers of the former *govh.APIError errors still find it
+	var govhErr *govh.APIError
+	if !errors.As(err, &govhErr) || govhErr.Code != http.StatusNotFound || govhErr.QueryID != apiErr.QueryID {
+		t.Fatalf("expected the wrapped *govh.APIError, got %v", err)
+	}
+
+	expired, err := server.Client(ovh.WithKeys(ovhtest.AppKey, ovhtest.AppSecret, "expired"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = expired.VrackList()
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode != "INVALID_CREDENTIAL" || !errors.Is(err, ovh.ErrAuth) {
+		t.Fatalf("expected an authentication error, got %v", err)
+	}
+
+	if _, err = client.CloudProjectSSHKeyCreate(project.ID, "laptop", "not a key"); !errors.Is(err, ovh.ErrInvalid) {
+		t.Fatalf("expected an invalid request error, got %v", err)
+	}
+}
+
+func TestErrorCategories(t *testing.T) {
+	tests := []struct {
+		err      *ovh.Error
+		category error
+	}{
+		{&ovh.Error{APIError: &govh.APIError{Code: 400}, Class: "Client::BadRequest"}, ovh.ErrInvalid},
+		{&ovh.Error{APIError: &govh.APIError{Code: 401}}, ovh.ErrAuth},
+		{&ovh.Error{APIError: &govh.APIError{Code: 403}, ErrorCode: "NOT_CREDENTIAL"}, ovh.ErrAuth},
+		{&ovh.Error{APIError: &govh.APIError{Code: 403}, Class: "Client::Forbidden"}, ovh.ErrForbidden},
+		{&ovh.Error{APIError: &govh.APIError{Code: 403, Message: "Quota exceeded for instances"}, Class: "Client::Forbidden::QuotaExceeded"}, ovh.ErrQuotaExceeded},
+		{&ovh.Error{APIError: &govh.APIError{Code: 413}}, ovh.ErrQuotaExceeded},
+		{&ovh.Error{APIError: &govh.APIError{Code: 400, Message: "Invalid quota name"}, Class: "Client::BadRequest"}, ovh.ErrInvalid},
+		{&ovh.Error{APIError: &govh.APIError{Code: 409}, Class: "Client::Conflict::AlreadyExists"}, ovh.ErrConflict},
+		{&ovh.Error{APIError: &govh.APIError{Code: 429}}, ovh.ErrRateLimited},
+		{&ovh.Error{APIError: &govh.APIError{Code: 503}}, ovh.ErrServer},
+	}
+	for _, test := range tests {
+		if category := test.err.Category(); category != test.category {
+			t.Errorf("%+v: expected %v, got %v", test.err, test.category, category)
+		}
+		if !errors.Is(test.err, test.category) {
+			t.Errorf("%+v: expected errors.Is to match %v", test.err, test.category)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// OpenStackCredentials are the credentials of a Cloud project user on the
+// OpenStack APIs of the project, for what the OVH API does not expose
+type OpenStackCredentials struct {
+	// AuthURL is the Keystone v3 endpoint, such as https://auth.cloud.ovh.net/v3
+	AuthURL  string
+	Username string
+	Password string
+	// ProjectID is the OpenStack ID of the Cloud project, the same as its OVH ID
+	ProjectID string
+	// DomainName is the domain of the user, Default if empty
+	DomainName string
+}
+
+// OpenStackCredentialsFromEnv returns the credentials set by an openrc file:
+// OS_AUTH_URL, OS_USERNAME, OS_PASSWORD, OS_PROJECT_ID or OS_TENANT_ID, and
+// OS_USER_DOMAIN_NAME
+func OpenStackCredentialsFromEnv() (*OpenStackCredentials, error) {
+	creds := &OpenStackCredentials{
+		AuthURL:    os.Getenv("OS_AUTH_URL"),
+		Username:   os.Getenv("OS_USERNAME"),
+		Password:   os.Getenv("OS_PASSWORD"),
+		ProjectID:  os.Getenv("OS_PROJECT_ID"),
+		DomainName: os.Getenv("OS_USER_DOMAIN_NAME"),
+	}
+	if creds.ProjectID == "" {
+		creds.ProjectID = os.Getenv("OS_TENANT_ID")
+	}
+	if creds.AuthURL == "" || creds.Username == "" || creds.Password == "" || creds.ProjectID == "" {
+		return nil, fmt.Errorf("OpenStack credentials missing, source the openrc file of a project user: %w", ErrAuth)
+	}
+	return creds, nil
+}
+
+// openStackService is a service of the catalog of a Keystone token
+type openStackService struct {
+	Type      string `json:"type"`
+	Endpoints []struct {
+		Interface string `json:"interface"`
+		Region    string `json:"region"`
+		URL       string `json:"url"`
+	} `json:"endpoints"`
+}
+
+// openStackToken is a Keystone token and the catalog of the services it gives access to
+type openStackToken struct {
+	id      string
+	catalog []openStackService
+}
+
+// endpoint returns the public endpoint of a service in a region
+func (t *openStackToken) endpoint(serviceType, region string) (string, error) {
+	for _, service := range t.catalog {
+		if service.Type != serviceType {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface == "public" && endpoint.Region == region {
+				return strings.TrimSuffix(endpoint.URL, "/"), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("OpenStack %s endpoint in region %s %w", serviceType, region, ErrNotFound)
+}
+
+// openStackCall runs a request on an OpenStack API, with token if set, and
+// returns the response headers
+func (c *Client) openStackCall(ctx context.Context, method, target, token string, reqBody, resType interface{}) (http.Header, error) {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	if token != "" {
+		req.Header.Add("X-Auth-Token", token)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	response, err := c.OVHClient.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	err = getResponse(response, resType)
+	var apiError *Error
+	if errors.As(err, &apiError) && apiError.Message == "" {
+		// OpenStack errors are wrapped in an object named after their type
+		wrapped := map[string]struct {
+			Message string `json:"message"`
+		}{}
+		json.Unmarshal(resBody, &wrapped)
+		for _, e := range wrapped {
+			apiError.Message = e.Message
+		}
+	}
+	return response.Header, err
+}
+
+// openStackAuth returns a Keystone token scoped to the project of creds
+func (c *Client) openStackAuth(ctx context.Context, creds *OpenStackCredentials) (*openStackToken, error) {
+	domain := creds.DomainName
+	if domain == "" {
+		domain = "Default"
+	}
+	req := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     creds.Username,
+						"domain":   map[string]string{"name": domain},
+						"password": creds.Password,
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]string{"id": creds.ProjectID},
+			},
+		},
+	}
+	res := struct {
+		Token struct {
+			Catalog []openStackService `json:"catalog"`
+		} `json:"token"`
+	}{}
+	headers, err := c.openStackCall(ctx, "POST", strings.TrimSuffix(creds.AuthURL, "/")+"/auth/tokens", "", req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &openStackToken{id: headers.Get("X-Subject-Token"), catalog: res.Token.Catalog}, nil
+}
+
+// CloudInstanceConsoleOutput returns the last lines of the console output of
+// a VM of region, all of them if lines is 0. The OVH API does not expose it,
+// so it is read from the OpenStack compute API with creds.
+func (c *Client) CloudInstanceConsoleOutput(creds *OpenStackCredentials, region, instanceID string, lines int) (string, error) {
+	return c.CloudInstanceConsoleOutputCtx(context.Background(), creds, region, instanceID, lines)
+}
+
+// CloudInstanceConsoleOutputCtx is CloudInstanceConsoleOutput with a context
+func (c *Client) CloudInstanceConsoleOutputCtx(ctx context.Context, creds *OpenStackCredentials, region, instanceID string, lines int) (string, error) {
+	token, err := c.openStackAuth(ctx, creds)
+	if err != nil {
+		return "", err
+	}
+	compute, err := token.endpoint("compute", region)
+	if err != nil {
+		return "", err
+	}
+
+	action := map[string]interface{}{}
+	if lines > 0 {
+		action["length"] = lines
+	}
+	res := struct {
+		Output string `json:"output"`
+	}{}
+	path := fmt.Sprintf("%s/servers/%s/action", compute, url.PathEscape(instanceID))
+	_, err = c.openStackCall(ctx, "POST", path, token.id, map[string]interface{}{"os-getConsoleOutput": action}, &res)
+	return res.Output, err
+}
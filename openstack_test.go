@@ -0,0 +1,80 @@
+package ovh_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestOpenStackCredentialsFromEnv(t *testing.T) {
+	env := map[string]string{
+		"OS_AUTH_URL":         "https://auth.cloud.ovh.net/v3",
+		"OS_USERNAME":         "user-1",
+		"OS_PASSWORD":         "secret",
+		"OS_PROJECT_ID":       "",
+		"OS_TENANT_ID":        "project-1",
+		"OS_USER_DOMAIN_NAME": "",
+	}
+	for name, value := range env {
+		old, ok := os.LookupEnv(name)
+		os.Setenv(name, value)
+		if ok {
+			defer os.Setenv(name, old)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+
+	creds, err := ovh.OpenStackCredentialsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ovh.OpenStackCredentials{AuthURL: "https://auth.cloud.ovh.net/v3", Username: "user-1", Password: "secret", ProjectID: "project-1"}
+	if *creds != expected {
+		t.Fatalf("expected %+v, got %+v", expected, *creds)
+	}
+
+	os.Setenv("OS_PASSWORD", "")
+	if _, err = ovh.OpenStackCredentialsFromEnv(); !errors.Is(err, ovh.ErrAuth) {
+		t.Fatalf("expected missing credentials, got %v", err)
+	}
+}
+
+func TestCloudInstanceConsoleOutput(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	server.AddRegion(project.ID, ovh.Region{Name: "GRA3"})
+	user := server.AddUser(project.ID, ovh.User{Username: "user-1", Password: "secret"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+	server.SetConsoleOutput(project.ID, instance.ID, "booting\ncloud-init: done\nweb-1 login:\n")
+
+	creds := &ovh.OpenStackCredentials{AuthURL: server.OpenStackAuthURL(), Username: user.Username, Password: user.Password, ProjectID: project.ID}
+	output, err := client.CloudInstanceConsoleOutput(creds, "GRA3", instance.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output != "booting\ncloud-init: done\nweb-1 login:\n" {
+		t.Fatalf("unexpected output %q", output)
+	}
+	if output, err = client.CloudInstanceConsoleOutput(creds, "GRA3", instance.ID, 2); err != nil || output != "cloud-init: done\nweb-1 login:\n" {
+		t.Fatalf("expected the last 2 lines, got %q, %v", output, err)
+	}
+
+	_, err = client.CloudInstanceConsoleOutput(creds, "SBG5", instance.ID, 0)
+	if !errors.Is(err, ovh.ErrNotFound) {
+		t.Fatalf("expected no endpoint in SBG5, got %v", err)
+	}
+	_, err = client.CloudInstanceConsoleOutput(creds, "GRA3", "missing", 0)
+	checkNotFound(t, err)
+	if !strings.Contains(err.Error(), "Instance missing could not be found") {
+		t.Fatalf("expected the message of the OpenStack error, got %q", err)
+	}
+
+	creds.Password = "wrong"
+	if _, err = client.CloudInstanceConsoleOutput(creds, "GRA3", instance.ID, 0); !errors.Is(err, ovh.ErrAuth) {
+		t.Fatalf("expected an authentication error, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"time"
@@ -21,8 +22,13 @@ type OrderCartUpdateReq struct {
 
 // OrderCartList list all your cart
 func (c *Client) OrderCartList() ([]OrderCart, error) {
+	return c.OrderCartListCtx(context.Background())
+}
+
+// OrderCartListCtx is OrderCartList with a context
+func (c *Client) OrderCartListCtx(ctx context.Context) ([]OrderCart, error) {
 	var ids []string
-	e := c.OVHClient.Get("/order/cart", &ids)
+	e := c.get(ctx, "/order/cart", &ids)
 	carts := []OrderCart{}
 	for _, id := range ids {
 		carts = append(carts, OrderCart{CartID: id})
@@ -32,53 +38,93 @@ func (c *Client) OrderCartList() ([]OrderCart, error) {
 
 // OrderCartInfo retrieve all infos of one of your cart
 func (c *Client) OrderCartInfo(cartID string) (*OrderCart, error) {
+	return c.OrderCartInfoCtx(context.Background(), cartID)
+}
+
+// OrderCartInfoCtx is OrderCartInfo with a context
+func (c *Client) OrderCartInfoCtx(ctx context.Context, cartID string) (*OrderCart, error) {
 	cart := &OrderCart{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), cart)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), cart)
 	return cart, err
 }
 
 // OrderCreateCart create a new cart
 func (c *Client) OrderCreateCart(cartCreateReq OrderCartCreateReq) (*OrderCart, error) {
+	return c.OrderCreateCartCtx(context.Background(), cartCreateReq)
+}
+
+// OrderCreateCartCtx is OrderCreateCart with a context
+func (c *Client) OrderCreateCartCtx(ctx context.Context, cartCreateReq OrderCartCreateReq) (*OrderCart, error) {
 	cart := &OrderCart{}
-	e := c.OVHClient.Post("/order/cart", cartCreateReq, cart)
+	e := c.post(ctx, "/order/cart", cartCreateReq, cart)
 	return cart, e
 }
 
 // OrderUpdateCart update a cart
 func (c *Client) OrderUpdateCart(cartID string, cartUpdateReq OrderCartUpdateReq) (*OrderCart, error) {
+	return c.OrderUpdateCartCtx(context.Background(), cartID, cartUpdateReq)
+}
+
+// OrderUpdateCartCtx is OrderUpdateCart with a context
+func (c *Client) OrderUpdateCartCtx(ctx context.Context, cartID string, cartUpdateReq OrderCartUpdateReq) (*OrderCart, error) {
 	cart := &OrderCart{}
-	e := c.OVHClient.Put(fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), cartUpdateReq, cart)
+	e := c.put(ctx, fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), cartUpdateReq, cart)
 	return cart, e
 }
 
 // OrderDeleteCart delete a cart
 func (c *Client) OrderDeleteCart(cartID string) error {
-	e := c.OVHClient.Delete(fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), nil)
+	return c.OrderDeleteCartCtx(context.Background(), cartID)
+}
+
+// OrderDeleteCartCtx is OrderDeleteCart with a context
+func (c *Client) OrderDeleteCartCtx(ctx context.Context, cartID string) error {
+	e := c.delete(ctx, fmt.Sprintf("/order/cart/%s", url.QueryEscape(cartID)), nil)
 	return e
 }
 
 // OrderAssignCart assign to connected user a cart
 func (c *Client) OrderAssignCart(cartID string) error {
-	e := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/assign", url.QueryEscape(cartID)), nil, nil)
+	return c.OrderAssignCartCtx(context.Background(), cartID)
+}
+
+// OrderAssignCartCtx is OrderAssignCart with a context
+func (c *Client) OrderAssignCartCtx(ctx context.Context, cartID string) error {
+	e := c.post(ctx, fmt.Sprintf("/order/cart/%s/assign", url.QueryEscape(cartID)), nil, nil)
 	return e
 }
 
 // OrderSummaryCart get a summary of your current order
 func (c *Client) OrderSummaryCart(cartID string) (*Order, error) {
+	return c.OrderSummaryCartCtx(context.Background(), cartID)
+}
+
+// OrderSummaryCartCtx is OrderSummaryCart with a context
+func (c *Client) OrderSummaryCartCtx(ctx context.Context, cartID string) (*Order, error) {
 	order := &Order{}
-	e := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/summary", url.QueryEscape(cartID)), order)
+	e := c.get(ctx, fmt.Sprintf("/order/cart/%s/summary", url.QueryEscape(cartID)), order)
 	return order, e
 }
 
 // OrderGetCheckoutCart get prices and contracts information for your cart
 func (c *Client) OrderGetCheckoutCart(cartID string) (*Order, error) {
+	return c.OrderGetCheckoutCartCtx(context.Background(), cartID)
+}
+
+// OrderGetCheckoutCartCtx is OrderGetCheckoutCart with a context
+func (c *Client) OrderGetCheckoutCartCtx(ctx context.Context, cartID string) (*Order, error) {
 	order := &Order{}
-	e := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/checkout", url.QueryEscape(cartID)), order)
+	e := c.get(ctx, fmt.Sprintf("/order/cart/%s/checkout", url.QueryEscape(cartID)), order)
 	return order, e
 }
 
 // OrderPostCheckoutCart validate your shopping and create order
 func (c *Client) OrderPostCheckoutCart(cartID string, waiveRetractationPeriod bool) (*Order, error) {
+	return c.OrderPostCheckoutCartCtx(context.Background(), cartID, waiveRetractationPeriod)
+}
+
+// OrderPostCheckoutCartCtx is OrderPostCheckoutCart with a context
+func (c *Client) OrderPostCheckoutCartCtx(ctx context.Context, cartID string, waiveRetractationPeriod bool) (*Order, error) {
 	order := &Order{}
 
 	data := struct {
@@ -87,14 +133,19 @@ func (c *Client) OrderPostCheckoutCart(cartID string, waiveRetractationPeriod bo
 		waiveRetractationPeriod,
 	}
 
-	e := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/checkout", url.QueryEscape(cartID)), data, order)
+	e := c.post(ctx, fmt.Sprintf("/order/cart/%s/checkout", url.QueryEscape(cartID)), data, order)
 	return order, e
 }
 
 // OrderCartItemList list all items in your cart
 func (c *Client) OrderCartItemList(cartID string) ([]OrderCartItem, error) {
+	return c.OrderCartItemListCtx(context.Background(), cartID)
+}
+
+// OrderCartItemListCtx is OrderCartItemList with a context
+func (c *Client) OrderCartItemListCtx(ctx context.Context, cartID string) ([]OrderCartItem, error) {
 	var ids []int
-	e := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/item", url.QueryEscape(cartID)), &ids)
+	e := c.get(ctx, fmt.Sprintf("/order/cart/%s/item", url.QueryEscape(cartID)), &ids)
 	items := []OrderCartItem{}
 	for _, id := range ids {
 		items = append(items, OrderCartItem{ItemID: id})
@@ -104,13 +155,23 @@ func (c *Client) OrderCartItemList(cartID string) ([]OrderCartItem, error) {
 
 // OrderCartItemInfo retrieve info of a cart item
 func (c *Client) OrderCartItemInfo(cartID string, itemID int) (*OrderCartItem, error) {
+	return c.OrderCartItemInfoCtx(context.Background(), cartID, itemID)
+}
+
+// OrderCartItemInfoCtx is OrderCartItemInfo with a context
+func (c *Client) OrderCartItemInfoCtx(ctx context.Context, cartID string, itemID int) (*OrderCartItem, error) {
 	item := &OrderCartItem{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), item)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), item)
 	return item, err
 }
 
 // OrderUpdateCartItem update a cart item
 func (c *Client) OrderUpdateCartItem(cartID string, itemID int, duration string, quantity int) (*OrderCartItem, error) {
+	return c.OrderUpdateCartItemCtx(context.Background(), cartID, itemID, duration, quantity)
+}
+
+// OrderUpdateCartItemCtx is OrderUpdateCartItem with a context
+func (c *Client) OrderUpdateCartItemCtx(ctx context.Context, cartID string, itemID int, duration string, quantity int) (*OrderCartItem, error) {
 	item := &OrderCartItem{}
 
 	data := struct {
@@ -121,20 +182,30 @@ func (c *Client) OrderUpdateCartItem(cartID string, itemID int, duration string,
 		quantity,
 	}
 
-	err := c.OVHClient.Put(fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), data, item)
+	err := c.put(ctx, fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), data, item)
 	return item, err
 }
 
 // OrderDeleteCartItem delete a cart item
 func (c *Client) OrderDeleteCartItem(cartID string, itemID int) (*OrderCartItem, error) {
-	err := c.OVHClient.Delete(fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), nil)
+	return c.OrderDeleteCartItemCtx(context.Background(), cartID, itemID)
+}
+
+// OrderDeleteCartItemCtx is OrderDeleteCartItem with a context
+func (c *Client) OrderDeleteCartItemCtx(ctx context.Context, cartID string, itemID int) (*OrderCartItem, error) {
+	err := c.delete(ctx, fmt.Sprintf("/order/cart/%s/item/%d", url.QueryEscape(cartID), itemID), nil)
 	return nil, err
 }
 
 // OrderCartConfigurationsList list all configurations for an item
 func (c *Client) OrderCartConfigurationsList(cartID string, itemID int) ([]OrderCartConfigurationItem, error) {
+	return c.OrderCartConfigurationsListCtx(context.Background(), cartID, itemID)
+}
+
+// OrderCartConfigurationsListCtx is OrderCartConfigurationsList with a context
+func (c *Client) OrderCartConfigurationsListCtx(ctx context.Context, cartID string, itemID int) ([]OrderCartConfigurationItem, error) {
 	var ids []int
-	e := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/item/%d/configuration", url.QueryEscape(cartID), itemID), &ids)
+	e := c.get(ctx, fmt.Sprintf("/order/cart/%s/item/%d/configuration", url.QueryEscape(cartID), itemID), &ids)
 	configs := []OrderCartConfigurationItem{}
 	for _, id := range ids {
 		configs = append(configs, OrderCartConfigurationItem{ID: id})
@@ -144,13 +215,23 @@ func (c *Client) OrderCartConfigurationsList(cartID string, itemID int) ([]Order
 
 // OrderCartConfigurationInfo get a configuration for an item
 func (c *Client) OrderCartConfigurationInfo(cartID string, itemID int, configID int) (*OrderCartConfigurationItem, error) {
+	return c.OrderCartConfigurationInfoCtx(context.Background(), cartID, itemID, configID)
+}
+
+// OrderCartConfigurationInfoCtx is OrderCartConfigurationInfo with a context
+func (c *Client) OrderCartConfigurationInfoCtx(ctx context.Context, cartID string, itemID int, configID int) (*OrderCartConfigurationItem, error) {
 	config := &OrderCartConfigurationItem{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/item/%d/configuration/%d", url.QueryEscape(cartID), itemID, configID), config)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/item/%d/configuration/%d", url.QueryEscape(cartID), itemID, configID), config)
 	return config, err
 }
 
 // OrderCartAddConfiguration add a configuration on an item
 func (c *Client) OrderCartAddConfiguration(cartID string, itemID int, label string, value string) (*OrderCartItem, error) {
+	return c.OrderCartAddConfigurationCtx(context.Background(), cartID, itemID, label, value)
+}
+
+// OrderCartAddConfigurationCtx is OrderCartAddConfiguration with a context
+func (c *Client) OrderCartAddConfigurationCtx(ctx context.Context, cartID string, itemID int, label string, value string) (*OrderCartItem, error) {
 	item := &OrderCartItem{}
 
 	data := struct {
@@ -160,19 +241,29 @@ func (c *Client) OrderCartAddConfiguration(cartID string, itemID int, label stri
 		label,
 		value,
 	}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/item/%d/configuration", url.QueryEscape(cartID), itemID), data, item)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/item/%d/configuration", url.QueryEscape(cartID), itemID), data, item)
 	return item, err
 }
 
 // OrderCartDeleteConfiguration remove a configuration from an item
 func (c *Client) OrderCartDeleteConfiguration(cartID string, itemID int, configID int) (*OrderCartItem, error) {
-	err := c.OVHClient.Delete(fmt.Sprintf("/order/cart/%s/item/%d/configuration/%d", url.QueryEscape(cartID), itemID, configID), nil)
+	return c.OrderCartDeleteConfigurationCtx(context.Background(), cartID, itemID, configID)
+}
+
+// OrderCartDeleteConfigurationCtx is OrderCartDeleteConfiguration with a context
+func (c *Client) OrderCartDeleteConfigurationCtx(ctx context.Context, cartID string, itemID int, configID int) (*OrderCartItem, error) {
+	err := c.delete(ctx, fmt.Sprintf("/order/cart/%s/item/%d/configuration/%d", url.QueryEscape(cartID), itemID, configID), nil)
 	return nil, err
 }
 
 // OrderCartRequiredConfigurations get required configurations for an item
 func (c *Client) OrderCartRequiredConfigurations(cartID string, itemID int) ([]OrderCartConfigurationRequirements, error) {
+	return c.OrderCartRequiredConfigurationsCtx(context.Background(), cartID, itemID)
+}
+
+// OrderCartRequiredConfigurationsCtx is OrderCartRequiredConfigurations with a context
+func (c *Client) OrderCartRequiredConfigurationsCtx(ctx context.Context, cartID string, itemID int) ([]OrderCartConfigurationRequirements, error) {
 	var configs []OrderCartConfigurationRequirements
-	e := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/item/%d/requiredConfiguration", url.QueryEscape(cartID), itemID), &configs)
+	e := c.get(ctx, fmt.Sprintf("/order/cart/%s/item/%d/requiredConfiguration", url.QueryEscape(cartID), itemID), &configs)
 	return configs, e
 }
@@ -0,0 +1,270 @@
+package ovh_test
+
+import (
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// domainOffers are the offers of the fake API for domain products
+var domainOffers = []ovh.OrderCartProductInformation{{
+	OfferID:   "gold",
+	Offer:     "gold",
+	ProductID: "domain",
+	Duration:  []string{"P1Y"},
+	Orderable: true,
+	Phase:     "ga",
+	Prices: []ovh.OrderCartPrice{
+		{Label: "PRICE", Price: ovh.OrderPrice{CurrencyCode: "EUR", Value: 10}},
+	},
+	Configurations: []ovh.OrderCartConfigurationRequirements{
+		{Label: "OWNER_CONTACT", Required: true, Type: "/me/contact"},
+	},
+}}
+
+func TestOrderCart(t *testing.T) {
+	server, client := newTestServer(t)
+
+	cart, err := client.OrderCreateCart(ovh.OrderCartCreateReq{OVHSubsidiary: "FR", Description: "domains"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cart.CartID == "" || cart.Expire == nil {
+		t.Fatalf("unexpected cart %+v", cart)
+	}
+
+	carts, err := client.OrderCartList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(carts) != 1 || carts[0].CartID != cart.CartID {
+		t.Fatalf("unexpected carts %+v", carts)
+	}
+
+	expire := time.Now().UTC().Truncate(time.Second).Add(time.Hour)
+	if _, err = client.OrderUpdateCart(cart.CartID, ovh.OrderCartUpdateReq{Description: "renamed", Expire: &expire}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := client.OrderCartInfo(cart.CartID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Description != "renamed" || !info.Expire.Equal(expire) {
+		t.Fatalf("expected the cart to be updated, got %+v", info)
+	}
+
+	if err = client.OrderDeleteCart(cart.CartID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.OrderCartInfo(cart.CartID)
+	checkNotFound(t, err)
+
+	if _, err = client.OrderCreateCart(ovh.OrderCartCreateReq{}); err == nil {
+		t.Fatal("expected an error without subsidiary")
+	}
+	if server.Queries() == 0 {
+		t.Fatal("expected the queries to be counted")
+	}
+}
+
+func TestOrderCartDomain(t *testing.T) {
+	server, client := newTestServer(t)
+	server.SetDomainOffers(domainOffers)
+	server.SetDomainOptions([]ovh.OrderCartGenericOptionDefinition{{PlanCode: "dnssec", ProductName: "DNSSEC", Family: "dns"}})
+
+	cart, err := client.OrderCreateCart(ovh.OrderCartCreateReq{OVHSubsidiary: "FR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offers, err := client.OrderGetProductsDomain(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offers) != 1 || offers[0].OfferID != "gold" {
+		t.Fatalf("unexpected offers %+v", offers)
+	}
+
+	item, err := client.OrderAddProductDomain(cart.CartID, ovh.OrderPostDomainReq{Domain: "example.com", OfferID: "gold", Quantity: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ItemID == 0 || item.Settings.Domain != "example.com" || item.Duration != "P1Y" {
+		t.Fatalf("unexpected item %+v", item)
+	}
+
+	options, err := client.OrderGetProductDomainOptions(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(options) != 1 || options[0].PlanCode != "dnssec" {
+		t.Fatalf("unexpected options %+v", options)
+	}
+	option, err := client.OrderAddProductDomainOption(cart.CartID, ovh.OrderPostDomainOptionReq{ItemID: item.ItemID, PlanCode: "dnssec", Duration: "P1Y"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.ParentItemID != item.ItemID {
+		t.Fatalf("unexpected option item %+v", option)
+	}
+
+	items, err := client.OrderCartItemList(cart.CartID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].ItemID != item.ItemID {
+		t.Fatalf("unexpected items %+v", items)
+	}
+	info, err := client.OrderCartItemInfo(cart.CartID, item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Options) != 1 || info.Options[0] != option.ItemID {
+		t.Fatalf("expected the option to be attached, got %+v", info)
+	}
+
+	if _, err = client.OrderDeleteCartItem(cart.CartID, option.ItemID); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := client.OrderUpdateCartItem(cart.CartID, item.ItemID, "P2Y", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Duration != "P2Y" {
+		t.Fatalf("unexpected updated item %+v", updated)
+	}
+
+	required, err := client.OrderCartRequiredConfigurations(cart.CartID, item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(required) != 1 || required[0].Label != "OWNER_CONTACT" {
+		t.Fatalf("unexpected required configurations %+v", required)
+	}
+
+	if _, err = client.OrderCartAddConfiguration(cart.CartID, item.ItemID, "OWNER_CONTACT", "/me/contact/42"); err != nil {
+		t.Fatal(err)
+	}
+	configs, err := client.OrderCartConfigurationsList(cart.CartID, item.ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configs) != 1 {
+		t.Fatalf("unexpected configurations %+v", configs)
+	}
+	config, err := client.OrderCartConfigurationInfo(cart.CartID, item.ItemID, configs[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Value != "/me/contact/42" {
+		t.Fatalf("unexpected configuration %+v", config)
+	}
+	if _, err = client.OrderCartDeleteConfiguration(cart.CartID, item.ItemID, config.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.OrderCartConfigurationInfo(cart.CartID, item.ItemID, config.ID)
+	checkNotFound(t, err)
+
+	summary, err := client.OrderSummaryCart(cart.CartID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summary.Details) != 1 || summary.Prices.WithoutTax.Value != 10 {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+
+	// a cart must be assigned before checkout
+	if _, err = client.OrderPostCheckoutCart(cart.CartID, false); err == nil {
+		t.Fatal("expected an error for a cart not assigned")
+	}
+	if err = client.OrderAssignCart(cart.CartID); err != nil {
+		t.Fatal(err)
+	}
+	checkout, err := client.OrderGetCheckoutCart(cart.CartID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkout.Contracts) == 0 || checkout.OrderID != 0 {
+		t.Fatalf("unexpected checkout %+v", checkout)
+	}
+	order, err := client.OrderPostCheckoutCart(cart.CartID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.OrderID == 0 || order.URL == "" {
+		t.Fatalf("unexpected order %+v", order)
+	}
+	if _, err = client.OrderUpdateCartItem(cart.CartID, item.ItemID, "P1Y", 1); err == nil {
+		t.Fatal("expected an error for a cart checked out")
+	}
+}
+
+func TestOrderCartDomainTransfer(t *testing.T) {
+	server, client := newTestServer(t)
+	server.SetDomainOffers(domainOffers)
+	server.SetDomainOptions([]ovh.OrderCartGenericOptionDefinition{{PlanCode: "dnssec"}})
+
+	cart, err := client.OrderCreateCart(ovh.OrderCartCreateReq{OVHSubsidiary: "FR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offers, err := client.OrderGetProductDomainTransfer(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offers) != 1 {
+		t.Fatalf("unexpected offers %+v", offers)
+	}
+
+	item, err := client.OrderAddProductDomainTransfer(cart.CartID, ovh.OrderPostDomainReq{Domain: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ProductID != "domainTransfer" || item.OfferID != "gold" {
+		t.Fatalf("unexpected item %+v", item)
+	}
+
+	options, err := client.OrderGetProductDomainTransferOptions(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(options) != 1 {
+		t.Fatalf("unexpected options %+v", options)
+	}
+	option, err := client.OrderAddProductDomainTransferOption(cart.CartID, ovh.OrderPostDomainOptionReq{ItemID: item.ItemID, PlanCode: "dnssec"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.ParentItemID != item.ItemID {
+		t.Fatalf("unexpected option item %+v", option)
+	}
+
+	restore, err := client.OrderGetProductDomainRestore(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restore) != 0 {
+		t.Fatalf("unexpected restore products %+v", restore)
+	}
+
+	packs, err := client.OrderGetProductDomainPacks(cart.CartID, "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packs) != 0 {
+		t.Fatalf("unexpected packs %+v", packs)
+	}
+	pack, err := client.OrderPostProductDomainPacks(cart.CartID, ovh.OrderPostDomainPacksReq{Domain: "example.com", PlanCode: "pack-start"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pack.ProductID != "domainPacks" {
+		t.Fatalf("unexpected pack item %+v", pack)
+	}
+
+	if _, err = client.OrderGetProductsDomain("", "example.com"); err == nil {
+		t.Fatal("expected an error without cart")
+	}
+}
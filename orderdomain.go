@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -34,112 +35,167 @@ type OrderPostDomainOptionReq struct {
 
 // OrderGetProductsDomain get products about a domain name
 func (c *Client) OrderGetProductsDomain(cartID string, domain string) ([]OrderCartProductInformation, error) {
+	return c.OrderGetProductsDomainCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductsDomainCtx is OrderGetProductsDomain with a context
+func (c *Client) OrderGetProductsDomainCtx(ctx context.Context, cartID string, domain string) ([]OrderCartProductInformation, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	products := []OrderCartProductInformation{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domain?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &products)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domain?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &products)
 	return products, err
 }
 
 // OrderAddProductDomain post a new domain in your cart
 func (c *Client) OrderAddProductDomain(cartID string, orderPostDomainReq OrderPostDomainReq) (*OrderCartItem, error) {
+	return c.OrderAddProductDomainCtx(context.Background(), cartID, orderPostDomainReq)
+}
+
+// OrderAddProductDomainCtx is OrderAddProductDomain with a context
+func (c *Client) OrderAddProductDomainCtx(ctx context.Context, cartID string, orderPostDomainReq OrderPostDomainReq) (*OrderCartItem, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	domainItem := &OrderCartItem{}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/domain", url.QueryEscape(cartID)), orderPostDomainReq, domainItem)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/domain", url.QueryEscape(cartID)), orderPostDomainReq, domainItem)
 	return domainItem, err
 }
 
 // OrderGetProductDomainOptions get informations about a domain name options
 func (c *Client) OrderGetProductDomainOptions(cartID string, domain string) ([]OrderCartGenericOptionDefinition, error) {
+	return c.OrderGetProductDomainOptionsCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductDomainOptionsCtx is OrderGetProductDomainOptions with a context
+func (c *Client) OrderGetProductDomainOptionsCtx(ctx context.Context, cartID string, domain string) ([]OrderCartGenericOptionDefinition, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	options := []OrderCartGenericOptionDefinition{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domain/options?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), options)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domain/options?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &options)
 
 	return options, err
 }
 
 // OrderAddProductDomainOption post an option on a domain item
 func (c *Client) OrderAddProductDomainOption(cartID string, orderPostDomainOptionReq OrderPostDomainOptionReq) (*OrderCartItem, error) {
+	return c.OrderAddProductDomainOptionCtx(context.Background(), cartID, orderPostDomainOptionReq)
+}
+
+// OrderAddProductDomainOptionCtx is OrderAddProductDomainOption with a context
+func (c *Client) OrderAddProductDomainOptionCtx(ctx context.Context, cartID string, orderPostDomainOptionReq OrderPostDomainOptionReq) (*OrderCartItem, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	optionItem := &OrderCartItem{}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/domain/options", url.QueryEscape(cartID)), orderPostDomainOptionReq, optionItem)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/domain/options", url.QueryEscape(cartID)), orderPostDomainOptionReq, optionItem)
 	return optionItem, err
 }
 
 // OrderGetProductDomainTransfer get informations about a domain transfer
 func (c *Client) OrderGetProductDomainTransfer(cartID string, domain string) ([]OrderCartProductInformation, error) {
+	return c.OrderGetProductDomainTransferCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductDomainTransferCtx is OrderGetProductDomainTransfer with a context
+func (c *Client) OrderGetProductDomainTransferCtx(ctx context.Context, cartID string, domain string) ([]OrderCartProductInformation, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	products := []OrderCartProductInformation{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domainTransfer?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), products)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domainTransfer?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &products)
 	return products, err
 }
 
 // OrderAddProductDomainTransfer post a new domain transfer in your cart
 func (c *Client) OrderAddProductDomainTransfer(cartID string, orderPostDomainReq OrderPostDomainReq) (*OrderCartItem, error) {
+	return c.OrderAddProductDomainTransferCtx(context.Background(), cartID, orderPostDomainReq)
+}
+
+// OrderAddProductDomainTransferCtx is OrderAddProductDomainTransfer with a context
+func (c *Client) OrderAddProductDomainTransferCtx(ctx context.Context, cartID string, orderPostDomainReq OrderPostDomainReq) (*OrderCartItem, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	domainTransferItem := &OrderCartItem{}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/domainTransfer", url.QueryEscape(cartID)), orderPostDomainReq, domainTransferItem)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/domainTransfer", url.QueryEscape(cartID)), orderPostDomainReq, domainTransferItem)
 	return domainTransferItem, err
 }
 
 // OrderGetProductDomainTransferOptions get informations about domain name transfer options
 func (c *Client) OrderGetProductDomainTransferOptions(cartID string, domain string) ([]OrderCartGenericOptionDefinition, error) {
+	return c.OrderGetProductDomainTransferOptionsCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductDomainTransferOptionsCtx is OrderGetProductDomainTransferOptions with a context
+func (c *Client) OrderGetProductDomainTransferOptionsCtx(ctx context.Context, cartID string, domain string) ([]OrderCartGenericOptionDefinition, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	options := []OrderCartGenericOptionDefinition{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domainTransfer/options?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), options)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domainTransfer/options?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &options)
 
 	return options, err
 }
 
 // OrderAddProductDomainTransferOption post an option on a domain transfer item
-func (c *Client) OrderAddProductDomainTransferOption(cartID string, orderPostDomainReq OrderPostDomainReq) (*OrderCartItem, error) {
+func (c *Client) OrderAddProductDomainTransferOption(cartID string, orderPostDomainOptionReq OrderPostDomainOptionReq) (*OrderCartItem, error) {
+	return c.OrderAddProductDomainTransferOptionCtx(context.Background(), cartID, orderPostDomainOptionReq)
+}
+
+// OrderAddProductDomainTransferOptionCtx is OrderAddProductDomainTransferOption with a context
+func (c *Client) OrderAddProductDomainTransferOptionCtx(ctx context.Context, cartID string, orderPostDomainOptionReq OrderPostDomainOptionReq) (*OrderCartItem, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	optionItem := &OrderCartItem{}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/domainTransfer/options", url.QueryEscape(cartID)), orderPostDomainReq, optionItem)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/domainTransfer/options", url.QueryEscape(cartID)), orderPostDomainOptionReq, optionItem)
 	return optionItem, err
 }
 
 // OrderGetProductDomainRestore get products for a domain restore
 func (c *Client) OrderGetProductDomainRestore(cartID string, domain string) ([]OrderCartGenericProductDefinition, error) {
+	return c.OrderGetProductDomainRestoreCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductDomainRestoreCtx is OrderGetProductDomainRestore with a context
+func (c *Client) OrderGetProductDomainRestoreCtx(ctx context.Context, cartID string, domain string) ([]OrderCartGenericProductDefinition, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	domainRestoreProducts := []OrderCartGenericProductDefinition{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domainRestore?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), domainRestoreProducts)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domainRestore?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &domainRestoreProducts)
 	return domainRestoreProducts, err
 }
 
 // OrderGetProductDomainPacks get informations about domain packs
 func (c *Client) OrderGetProductDomainPacks(cartID string, domain string) ([]OrderCartDomainPacksProductInformation, error) {
+	return c.OrderGetProductDomainPacksCtx(context.Background(), cartID, domain)
+}
+
+// OrderGetProductDomainPacksCtx is OrderGetProductDomainPacks with a context
+func (c *Client) OrderGetProductDomainPacksCtx(ctx context.Context, cartID string, domain string) ([]OrderCartDomainPacksProductInformation, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	domainPacksProducts := []OrderCartDomainPacksProductInformation{}
-	err := c.OVHClient.Get(fmt.Sprintf("/order/cart/%s/domainPacks?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), domainPacksProducts)
+	err := c.get(ctx, fmt.Sprintf("/order/cart/%s/domainPacks?domain=%s", url.QueryEscape(cartID), url.QueryEscape(domain)), &domainPacksProducts)
 	return domainPacksProducts, err
 }
 
 // OrderPostProductDomainPacks post a new domain packs in your cart
 func (c *Client) OrderPostProductDomainPacks(cartID string, orderPostDomainPacksReq OrderPostDomainPacksReq) (*OrderCartItem, error) {
+	return c.OrderPostProductDomainPacksCtx(context.Background(), cartID, orderPostDomainPacksReq)
+}
+
+// OrderPostProductDomainPacksCtx is OrderPostProductDomainPacks with a context
+func (c *Client) OrderPostProductDomainPacksCtx(ctx context.Context, cartID string, orderPostDomainPacksReq OrderPostDomainPacksReq) (*OrderCartItem, error) {
 	if cartID == "" {
 		return nil, errors.New("Error 404: \"Invalid Cart ID\"")
 	}
 	domainPacksItem := &OrderCartItem{}
-	err := c.OVHClient.Post(fmt.Sprintf("/order/cart/%s/domainTransfer", url.QueryEscape(cartID)), orderPostDomainPacksReq, domainPacksItem)
+	err := c.post(ctx, fmt.Sprintf("/order/cart/%s/domainPacks", url.QueryEscape(cartID)), orderPostDomainPacksReq, domainPacksItem)
 	return domainPacksItem, err
 }
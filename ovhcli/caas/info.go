@@ -1,7 +1,6 @@
 package caas
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -17,7 +16,7 @@ var cmdContainersServiceInfo = &cobra.Command{
 	Use:   "info",
 	Short: "Info about a project: ovhcli caas info",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		containersserviceinfo, err := client.ContainersServiceInfo(containerservid)
@@ -1,6 +1,8 @@
 package caas
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
@@ -17,20 +19,17 @@ var cmdContainersServicesList = &cobra.Command{
 	Use:   "list",
 	Short: "List all containers services: ovhcli caas list",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		containersservices, err := client.ContainersServicesList()
 		common.Check(err)
 
 		if withDetails {
-			contComplete := []ovh.ContainersService{}
-			for _, cont := range containersservices {
-				c, err := client.ContainersServiceInfo(cont.Name)
-				common.Check(err)
-				contComplete = append(contComplete, *c)
-			}
-			containersservices = contComplete
+			containersservices, err = ovh.FetchDetails(context.Background(), client, containersservices, func(ctx context.Context, cont ovh.ContainersService) (*ovh.ContainersService, error) {
+				return client.ContainersServiceInfoCtx(ctx, cont.Name)
+			})
+			common.Check(err)
 		}
 
 		common.FormatOutputDef(containersservices)
@@ -1,7 +1,6 @@
 package instance
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -28,7 +27,7 @@ var cmdInstanceCreate = &cobra.Command{
 	Use:   "create",
 	Short: "Create Cloud Public Instance: ovhcli cloud instance create",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		c, err := client.CloudCreateInstance(projectID, name, pubkeyID, flavorID, imageID, region)
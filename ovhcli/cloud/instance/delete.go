@@ -3,7 +3,6 @@ package instance
 import (
 	"fmt"
 
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -22,7 +21,7 @@ var cmdInstanceDelete = &cobra.Command{
 	Short: "Delete Cloud Public Instance: ovhcli cloud instance delete",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		err = client.CloudDeleteInstance(projectID, instanceID)
@@ -1,7 +1,6 @@
 package instance
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -16,7 +15,7 @@ var cmdInstanceInfo = &cobra.Command{
 	Use:   "info",
 	Short: "Info about an cloud instance: ovhcli cloud instance info",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		instance, err := client.CloudInfoInstance(projectID, instanceID)
@@ -1,7 +1,6 @@
 package instance
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -19,7 +18,7 @@ var cmdInstanceList = &cobra.Command{
 	Short: "List all instance: ovhcli cloud instance list",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		instances, err := client.CloudListInstance(projectID)
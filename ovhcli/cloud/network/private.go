@@ -1,10 +1,20 @@
 package network
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
 
 func init() {
 	cmdCloudNetworkPrivate.AddCommand(cmdCloudNetworkPrivateShow)
+	cmdCloudNetworkPrivate.AddCommand(cmdCloudNetworkPrivateCreate)
+	cmdCloudNetworkPrivate.AddCommand(cmdCloudNetworkPrivateDelete)
+	cmdCloudNetworkPrivate.AddCommand(cmdCloudNetworkPrivateSubnet)
 
+	cmdCloudNetworkPrivate.PersistentFlags().StringVarP(&project, "project", "", "", "Your Project, by ID or name")
 }
 
 // cmdCloudNetworkPrivate ...
@@ -13,3 +23,39 @@ var cmdCloudNetworkPrivate = &cobra.Command{
 	Short: "Network commands: ovhcli cloud network private --help",
 	Long:  `Network commands: ovhcli cloud network private <command>`,
 }
+
+// projectID returns the ID of the project of --project, given by name or ID
+func projectID(cmd *cobra.Command, client *ovh.Client) string {
+	if project == "" {
+		common.WrongUsage(cmd)
+	}
+	p, err := client.CloudProjectInfoByName(project)
+	common.Check(err)
+	return p.ID
+}
+
+// findPrivateNetwork returns the private network of a project with the name
+// or the ID nameOrID. Names shared by several networks are rejected.
+func findPrivateNetwork(client *ovh.Client, projectID, nameOrID string) (*ovh.Network, error) {
+	networks, err := client.CloudInfoNetworkPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.Network
+	for i := range networks {
+		if networks[i].ID == nameOrID {
+			return &networks[i], nil
+		}
+		if networks[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several networks are named %s, use an ID", nameOrID)
+			}
+			found = &networks[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Network %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
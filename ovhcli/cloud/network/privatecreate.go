@@ -0,0 +1,46 @@
+package network
+
+import (
+	"context"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	vlanID  int
+	regions []string
+)
+
+func init() {
+	cmdCloudNetworkPrivateCreate.Flags().IntVar(&vlanID, "vlan", 0, "VLAN of the network in the vRack, 0 for the untagged VLAN")
+	cmdCloudNetworkPrivateCreate.Flags().StringSliceVar(&regions, "region", nil, "Region of the network, repeated for several regions. All regions if empty")
+	common.AddWaitFlags(cmdCloudNetworkPrivateCreate)
+}
+
+// cmdCloudNetworkPrivateCreate creates a private network
+var cmdCloudNetworkPrivateCreate = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a private network in the vRack of your project: ovhcli cloud network private create <name> --vlan <id> --region <region>",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			common.WrongUsage(cmd)
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+		id := projectID(cmd, client)
+
+		network, err := client.CloudCreateNetworkPrivateWithOpts(id, ovh.NetworkPrivateCreateOpts{Name: args[0], VlanID: vlanID, Regions: regions})
+		common.Check(err)
+
+		if common.Wait {
+			network, err = client.WaitNetworkPrivateActive(context.Background(), id, network.ID, common.WaitOptions("Network "+network.Name)...)
+			common.Check(err)
+		}
+
+		common.FormatOutputDef(network)
+	},
+}
@@ -0,0 +1,30 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+// cmdCloudNetworkPrivateDelete deletes a private network
+var cmdCloudNetworkPrivateDelete = &cobra.Command{
+	Use:   "delete <network>",
+	Short: "Delete a private network and its subnets, given its name or ID: ovhcli cloud network private delete <network>",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			common.WrongUsage(cmd)
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+		id := projectID(cmd, client)
+
+		network, err := findPrivateNetwork(client, id, args[0])
+		common.Check(err)
+
+		common.Check(client.CloudDeleteNetworkPrivate(id, network.ID))
+		fmt.Printf("Network %s deleted\n", network.Name)
+	},
+}
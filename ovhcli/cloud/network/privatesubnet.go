@@ -0,0 +1,172 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	subnetRegion    string
+	subnetCIDR      string
+	subnetStart     string
+	subnetEnd       string
+	subnetNoDHCP    bool
+	subnetNoGateway bool
+)
+
+func init() {
+	cmdCloudNetworkPrivateSubnet.AddCommand(cmdCloudNetworkPrivateSubnetList)
+	cmdCloudNetworkPrivateSubnet.AddCommand(cmdCloudNetworkPrivateSubnetCreate)
+	cmdCloudNetworkPrivateSubnet.AddCommand(cmdCloudNetworkPrivateSubnetDelete)
+
+	cmdCloudNetworkPrivateSubnetCreate.Flags().StringVar(&subnetRegion, "region", "", "Region of the subnet")
+	cmdCloudNetworkPrivateSubnetCreate.Flags().StringVar(&subnetCIDR, "cidr", "", "IPv4 network of the subnet, such as 10.0.0.0/24")
+	cmdCloudNetworkPrivateSubnetCreate.Flags().StringVar(&subnetStart, "start", "", "First IP of the allocation pool. The third IP of the network if empty")
+	cmdCloudNetworkPrivateSubnetCreate.Flags().StringVar(&subnetEnd, "end", "", "Last IP of the allocation pool. The last IP before broadcast if empty")
+	cmdCloudNetworkPrivateSubnetCreate.Flags().BoolVar(&subnetNoDHCP, "no-dhcp", false, "Do not give the IPs of the allocation pool by DHCP")
+	cmdCloudNetworkPrivateSubnetCreate.Flags().BoolVar(&subnetNoGateway, "no-gateway", false, "Create the subnet without gateway")
+	cmdCloudNetworkPrivateSubnetCreate.MarkFlagRequired("region")
+	cmdCloudNetworkPrivateSubnetCreate.MarkFlagRequired("cidr")
+
+	cmdCloudNetworkPrivateSubnetDelete.Flags().StringVar(&subnetRegion, "region", "", "Region of the subnet, to tell apart the subnets of several regions with the same CIDR")
+}
+
+// cmdCloudNetworkPrivateSubnet ...
+var cmdCloudNetworkPrivateSubnet = &cobra.Command{
+	Use:   "subnet",
+	Short: "Subnet commands: ovhcli cloud network private subnet --help",
+	Long:  `Subnet commands: ovhcli cloud network private subnet <command>`,
+}
+
+// cmdCloudNetworkPrivateSubnetList lists the subnets of a private network
+var cmdCloudNetworkPrivateSubnetList = &cobra.Command{
+	Use:   "list <network>",
+	Short: "List the subnets of a private network, given its name or ID: ovhcli cloud network private subnet list <network>",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, id, network := networkFromArgs(cmd, args, 1)
+
+		subnets, err := client.CloudListSubnets(id, network.ID)
+		common.Check(err)
+		common.FormatOutputDef(subnets)
+	},
+}
+
+// cmdCloudNetworkPrivateSubnetCreate creates a subnet of a private network
+var cmdCloudNetworkPrivateSubnetCreate = &cobra.Command{
+	Use:   "create <network>",
+	Short: "Create a subnet of a private network, given its name or ID: ovhcli cloud network private subnet create <network> --region <region> --cidr <cidr>",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, id, network := networkFromArgs(cmd, args, 1)
+
+		start, end, err := allocationPool(subnetCIDR)
+		common.Check(err)
+		if subnetStart != "" {
+			start = subnetStart
+		}
+		if subnetEnd != "" {
+			end = subnetEnd
+		}
+
+		subnet, err := client.CloudCreateSubnet(id, network.ID, ovh.SubnetCreateOpts{
+			Region:    subnetRegion,
+			Network:   subnetCIDR,
+			Start:     start,
+			End:       end,
+			DHCP:      !subnetNoDHCP,
+			NoGateway: subnetNoGateway,
+		})
+		common.Check(err)
+		common.FormatOutputDef(subnet)
+	},
+}
+
+// cmdCloudNetworkPrivateSubnetDelete deletes a subnet of a private network
+var cmdCloudNetworkPrivateSubnetDelete = &cobra.Command{
+	Use:   "delete <network> <subnet>",
+	Short: "Delete a subnet, given its ID or CIDR, of a private network, given its name or ID: ovhcli cloud network private subnet delete <network> <subnet> [--region <region>]",
+	Run: func(cmd *cobra.Command, args []string) {
+		client, id, network := networkFromArgs(cmd, args, 2)
+
+		subnets, err := client.CloudListSubnets(id, network.ID)
+		common.Check(err)
+
+		subnet, err := findSubnet(subnets, args[1], subnetRegion)
+		common.Check(err)
+		common.Check(client.CloudDeleteSubnet(id, network.ID, subnet.ID))
+		fmt.Printf("Subnet %s deleted\n", subnet.CIDR)
+	},
+}
+
+// findSubnet returns the subnet with the ID or the CIDR idOrCIDR, of region if
+// not empty. CIDRs shared by several subnets are rejected.
+func findSubnet(subnets []ovh.Subnet, idOrCIDR, region string) (*ovh.Subnet, error) {
+	var found *ovh.Subnet
+	for i := range subnets {
+		if subnets[i].ID == idOrCIDR {
+			return &subnets[i], nil
+		}
+		if subnets[i].CIDR == idOrCIDR && (region == "" || subnetInRegion(subnets[i], region)) {
+			if found != nil {
+				return nil, fmt.Errorf("Several subnets have CIDR %s, use --region or an ID", idOrCIDR)
+			}
+			found = &subnets[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Subnet %s %w", idOrCIDR, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// subnetInRegion tells whether subnet has IPs in region
+func subnetInRegion(subnet ovh.Subnet, region string) bool {
+	for _, pool := range subnet.IPPools {
+		if pool.Region == region {
+			return true
+		}
+	}
+	return false
+}
+
+// networkFromArgs returns a client, the project ID and the private network
+// named or identified by the first of n arguments
+func networkFromArgs(cmd *cobra.Command, args []string, n int) (*ovh.Client, string, *ovh.Network) {
+	if len(args) != n {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+	id := projectID(cmd, client)
+
+	network, err := findPrivateNetwork(client, id, args[0])
+	common.Check(err)
+	return client, id, network
+}
+
+// allocationPool returns the default allocation pool of an IPv4 network: from
+// its third IP, after the network address and the gateway, to the IP before
+// its broadcast address
+func allocationPool(cidr string) (string, string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() == nil {
+		return "", "", fmt.Errorf("Invalid IPv4 network: %s", cidr)
+	}
+	ones, bits := network.Mask.Size()
+	if bits-ones < 3 {
+		return "", "", fmt.Errorf("Network %s is too small", cidr)
+	}
+
+	first := binary.BigEndian.Uint32(network.IP.To4())
+	last := first | (1<<uint(bits-ones) - 1)
+	start, end := make(net.IP, 4), make(net.IP, 4)
+	binary.BigEndian.PutUint32(start, first+2)
+	binary.BigEndian.PutUint32(end, last-1)
+	return start.String(), end.String(), nil
+}
@@ -1,7 +1,6 @@
 package network
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ var cmdCloudNetworkPublicShow = &cobra.Command{
 	Use:   "show",
 	Short: "Show the public network ID of your project: ovhcli cloud network public show",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		netpub, err := client.CloudInfoNetworkPublic(project)
@@ -6,9 +6,16 @@ func init() {
 	Cmd.AddCommand(cmdProjectList)
 	Cmd.AddCommand(cmdProjectInfo)
 	Cmd.AddCommand(cmdProjectImage)
+	Cmd.AddCommand(cmdProjectFlavor)
 	Cmd.AddCommand(cmdProjectUser)
 	Cmd.AddCommand(cmdProjectRegion)
 	Cmd.AddCommand(cmdProjectInstance)
+	Cmd.AddCommand(cmdProjectSnapshot)
+	Cmd.AddCommand(cmdProjectVolume)
+	Cmd.AddCommand(cmdProjectStorage)
+	Cmd.AddCommand(cmdProjectIP)
+	Cmd.AddCommand(cmdProjectQuota)
+	Cmd.AddCommand(cmdProjectCost)
 
 	Cmd.PersistentFlags().StringVarP(&projectID, "id", "", "", "Your ID Project")
 	Cmd.PersistentFlags().StringVarP(&projectName, "name", "", "", "Your Project Name")
@@ -0,0 +1,108 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectCost.Flags().StringVar(&costFrom, "from", "", "First day of the past usages to sum, as 2006-01-02")
+	cmdProjectCost.Flags().StringVar(&costTo, "to", "", "Last day of the past usages to sum, as 2006-01-02, today by default")
+	cmdProjectCost.Flags().StringVar(&costGroupBy, "group-by", "", "Group the costs by instance, region or resource-type")
+	cmdProjectCost.Flags().BoolVar(&costForecast, "forecast", false, "Sum the forecast of the usage of the current month")
+}
+
+var (
+	costFrom     string
+	costTo       string
+	costGroupBy  string
+	costForecast bool
+
+	cmdProjectCost = &cobra.Command{
+		Use:   "cost",
+		Short: "Show the costs of a project, by currency",
+		Long: `Show the costs of a project, by currency
+
+The costs are the ones of the current month, of the forecast of the current
+month with --forecast, or of the months overlapping --from and --to. With
+--group-by, they are grouped by instance, region or resource-type, followed by
+the totals, without group. Use --format csv or json to export them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			switch costGroupBy {
+			case "", ovh.CostByInstance, ovh.CostByRegion, ovh.CostByResourceType:
+			default:
+				common.WrongUsage(cmd)
+			}
+			if costForecast && (costFrom != "" || costTo != "") {
+				common.WrongUsage(cmd)
+			}
+			var from, to time.Time
+			var err error
+			if costFrom != "" {
+				from, err = time.Parse("2006-01-02", costFrom)
+				common.Check(err)
+			}
+			if costTo != "" {
+				to, err = time.Parse("2006-01-02", costTo)
+				common.Check(err)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			usages, err := projectUsages(client, from, to)
+			common.Check(err)
+
+			costs, err := ovh.UsageCosts(usages, costGroupBy)
+			common.Check(err)
+			if costGroupBy != "" {
+				totals, err := ovh.UsageCosts(usages, "")
+				common.Check(err)
+				costs = append(costs, totals...)
+			}
+			common.FormatOutputDef(costs)
+		},
+	}
+)
+
+// projectUsages returns the usages to sum: the forecast with --forecast, the
+// current usage without dates, or else the past usages overlapping from-to and
+// the current usage if to is not before it
+func projectUsages(client *ovh.Client, from, to time.Time) ([]ovh.Usage, error) {
+	if costForecast {
+		forecast, err := client.CloudUsageForecast(projectID)
+		if err != nil {
+			return nil, err
+		}
+		return []ovh.Usage{*forecast}, nil
+	}
+
+	current, err := client.CloudUsageCurrent(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if from.IsZero() && to.IsZero() {
+		return []ovh.Usage{*current}, nil
+	}
+
+	history, err := client.CloudListUsageHistory(projectID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := ovh.FetchDetails(context.Background(), client, history, func(ctx context.Context, usage ovh.Usage) (*ovh.Usage, error) {
+		return client.CloudUsageHistoryCtx(ctx, projectID, usage.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if start, err := time.Parse(time.RFC3339, current.Period.From); to.IsZero() || err != nil || !to.Before(start) {
+		usages = append(usages, *current)
+	}
+	return usages, nil
+}
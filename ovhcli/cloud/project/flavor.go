@@ -0,0 +1,60 @@
+package project
+
+import (
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectFlavor.AddCommand(cmdProjectFlavorList)
+
+	cmdProjectFlavorList.Flags().IntVar(&flavorFilter.MinVcpus, "min-vcpus", 0, "Minimum number of vCPUs")
+	cmdProjectFlavorList.Flags().IntVar(&flavorFilter.MinRAM, "min-ram", 0, "Minimum RAM in GB")
+	cmdProjectFlavorList.Flags().StringVar(&flavorFilter.Type, "type", "", "Type of flavors, such as ovh.ssd.eg, or family of their names, such as b2")
+	cmdProjectFlavorList.Flags().StringVar(&flavorFilter.OS, "os", "", "OS type of flavors, linux or windows")
+	cmdProjectFlavorList.Flags().BoolVar(&flavorNoPrice, "no-price", false, "Do not fetch the prices of the catalog")
+	cmdProjectFlavorList.Flags().StringVar(&flavorSubsidiary, "ovhSubsidiary", "FR", "OVH Subsidiary of the catalog of prices")
+}
+
+var (
+	flavorFilter     ovh.FlavorFilter
+	flavorNoPrice    bool
+	flavorSubsidiary string
+
+	cmdProjectFlavor = &cobra.Command{
+		Use:   "flavor",
+		Short: "Project flavors",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectFlavorList = &cobra.Command{
+		Use:   "list",
+		Short: "List flavors with their hourly and monthly prices",
+		Run: func(cmd *cobra.Command, args []string) {
+			if flavorFilter.OS != "" && flavorFilter.OS != "linux" && flavorFilter.OS != "windows" {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			flavors, err := client.CloudProjectFlavorsList(projectID, regionName)
+			common.Check(err)
+			flavors = ovh.FilterFlavors(flavors, flavorFilter)
+
+			if flavorNoPrice {
+				common.FormatOutputDef(flavors)
+				return
+			}
+
+			catalog, err := client.CloudCatalog(flavorSubsidiary)
+			common.Check(err)
+			common.FormatOutputDef(catalog.FlavorPrices(flavors))
+		},
+	}
+)
@@ -9,9 +9,16 @@ import (
 func init() {
 	cmdProjectImage.AddCommand(cmdProjectImageList)
 	cmdProjectImage.AddCommand(cmdProjectImageSearch)
+	cmdProjectImage.AddCommand(cmdProjectImageLatest)
+
+	cmdProjectImageSearch.Flags().StringVar(&imageFilter.Visibility, "visibility", "", "public for the images of OVH, private for snapshots")
+	cmdProjectImageSearch.Flags().StringVar(&imageFilter.OS, "os", "", "OS type of images, linux or windows")
+	cmdProjectImageSearch.Flags().IntVar(&imageFilter.Disk, "disk", 0, "Keep the images fitting in a disk of this size in GB, such as the disk of a flavor")
 }
 
 var (
+	imageFilter ovh.ImageFilter
+
 	cmdProjectImage = &cobra.Command{
 		Use:   "image",
 		Short: "Project image & snapshots management",
@@ -24,18 +31,10 @@ var (
 		Use:   "list",
 		Short: "List images & snapshots",
 		Run: func(cmd *cobra.Command, args []string) {
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" {
-				common.WrongUsage(cmd)
-			}
+			resolveProject(cmd, client)
 
 			images, err := client.CloudProjectImagesList(projectID, regionName)
 			common.Check(err)
@@ -50,29 +49,49 @@ var (
 	}
 
 	cmdProjectImageSearch = &cobra.Command{
-		Use:   "search <term0> [term1] [...] [termN]",
-		Short: "Search images & snapshots",
+		Use:   "search [term0] [term1] [...] [termN]",
+		Short: "Search images & snapshots matching any term, and the filters",
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) == 0 {
+			if len(args) == 0 && imageFilter.Visibility == "" && imageFilter.OS == "" && imageFilter.Disk == 0 {
 				common.WrongUsage(cmd)
 			}
 
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			imageFilter.Terms = args
+			images, err := client.CloudSearchImages(projectID, regionName, imageFilter)
 			common.Check(err)
+			common.FormatOutputDef(images)
+		},
+	}
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
+	cmdProjectImageLatest = &cobra.Command{
+		Use:   "latest <distribution>",
+		Short: "Show the image of the latest version of a distribution, such as Debian or \"Ubuntu LTS\"",
+		Long: `Show the image of the latest version of a distribution, such as Debian or "Ubuntu LTS"
+
+Images are named after their distribution and version, such as "Debian 12".
+"Ubuntu LTS" keeps the long term support versions of Ubuntu. Other commands
+take such images as latest:<distribution>, such as --image "latest:Ubuntu LTS".`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				common.WrongUsage(cmd)
 			}
 
-			if projectID == "" {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+			if regionName == "" {
 				common.WrongUsage(cmd)
 			}
 
-			images, err := client.CloudProjectImagesSearch(projectID, regionName, args...)
+			img, err := findImage(client, regionName, latestImagePrefix+args[0])
 			common.Check(err)
-			common.FormatOutputDef(images)
+			common.FormatOutputDef(img)
 		},
 	}
 )
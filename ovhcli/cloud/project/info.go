@@ -12,7 +12,7 @@ var (
 		Use:   "info",
 		Short: "Info about a project",
 		Run: func(cmd *cobra.Command, args []string) {
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
 			if projectID == "" && projectName == "" {
@@ -1,7 +1,10 @@
 package project
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
@@ -11,13 +14,23 @@ import (
 func init() {
 	cmdProjectInstance.AddCommand(cmdProjectInstanceList)
 	cmdProjectInstance.AddCommand(cmdProjectInstanceCreate)
-
-	cmdProjectInstanceCreate.Flags().StringVar(&instanceImage, "image", "", "Define image")
-	cmdProjectInstanceCreate.Flags().StringVar(&instanceFlavor, "flavor", "", "Define flavor")
-	cmdProjectInstanceCreate.Flags().StringVar(&instanceSSHKey, "sshKey", "", "Define ssh key")
+	cmdProjectInstance.AddCommand(cmdProjectInstanceInfo)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceDelete)
+
+	cmdProjectInstanceCreate.Flags().StringVar(&instanceImage, "image", "", "Define image, by name or ID")
+	cmdProjectInstanceCreate.Flags().StringVar(&instanceFlavor, "flavor", "", "Define flavor, by name or ID")
+	cmdProjectInstanceCreate.Flags().StringVar(&instanceSSHKey, "sshKey", "", "Define ssh key, by name or ID")
+	cmdProjectInstanceCreate.Flags().IntVar(&instanceCount, "count", 1, "Number of instances to create, named from the name template")
+	cmdProjectInstanceCreate.Flags().StringVar(&instanceUserData, "user-data", "", "File of user data run on the first boot, such as a cloud-init file")
+	cmdProjectInstanceCreate.Flags().StringSliceVar(&instanceNetworks, "network", nil, "Network to plug, by name or ID. The public network is plugged too, unless --no-public-network")
+	cmdProjectInstanceCreate.Flags().BoolVar(&instanceNoPublicNetwork, "no-public-network", false, "Plug only the networks of --network")
+	cmdProjectInstanceCreate.Flags().BoolVar(&instanceMonthly, "monthly", false, "Bill the instances monthly instead of hourly")
+	cmdProjectInstanceCreate.Flags().StringVar(&instanceGroup, "group", "", "Instance group to join, by name or ID, such as an anti-affinity group spreading the instances on different hosts")
 	cmdProjectInstanceCreate.MarkFlagRequired("image")
 	cmdProjectInstanceCreate.MarkFlagRequired("flavor")
 	cmdProjectInstanceCreate.MarkFlagRequired("sshKey")
+	common.AddWaitFlags(cmdProjectInstanceCreate)
+	common.AddWaitFlags(cmdProjectInstanceDelete)
 }
 
 var (
@@ -25,6 +38,13 @@ var (
 	instanceFlavor string
 	instanceSSHKey string
 
+	instanceCount           int
+	instanceUserData        string
+	instanceNetworks        []string
+	instanceNoPublicNetwork bool
+	instanceMonthly         bool
+	instanceGroup           string
+
 	cmdProjectInstance = &cobra.Command{
 		Use:   "instance",
 		Short: "Project instances management",
@@ -34,82 +54,185 @@ var (
 	}
 
 	cmdProjectInstanceCreate = &cobra.Command{
-		Use:   "create <Server>",
-		Short: "Create instance",
+		Use:   "create <name>",
+		Short: "Create instances",
+		Long: `Create instances
+
+With --count, the name is a template of the names of the instances, with the
+index of each instance from 1, such as web-{{.Index}}. The index is appended
+to names without template.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) != 1 {
+			if len(args) != 1 || instanceCount < 1 {
 				common.WrongUsage(cmd)
 			}
 
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" || regionName == "" {
+			resolveProject(cmd, client)
+			if regionName == "" {
 				common.WrongUsage(cmd)
 			}
 
-			imgs, err := client.CloudProjectImagesList(projectID, regionName)
+			img, err := findImage(client, regionName, instanceImage)
 			common.Check(err)
 
-			snaps, err := client.CloudProjectSnapshotsList(projectID, regionName)
+			f, err := findFlavor(client, regionName, instanceFlavor)
 			common.Check(err)
 
-			imgs = append(imgs, snaps...)
+			// fail before creating some of the instances only, other errors
+			// are left to the creation
+			if err := client.CloudCheckInstanceQuota(projectID, regionName, *f, instanceCount); errors.Is(err, ovh.ErrQuotaExceeded) {
+				common.Check(err)
+			}
 
-			var img *ovh.Image
-			for i := range imgs {
-				if imgs[i].Name == instanceImage && imgs[i].Region == regionName {
-					img = &imgs[i]
-					break
-				}
+			k, err := findSSHKey(client, instanceSSHKey)
+			common.Check(err)
+
+			opts := ovh.InstanceCreateOpts{
+				Name:           args[0],
+				FlavorID:       f.ID,
+				ImageID:        img.ID,
+				Region:         regionName,
+				SSHKeyID:       k.ID,
+				MonthlyBilling: instanceMonthly,
 			}
 
-			if img == nil {
-				common.Check(fmt.Errorf("Image %s not found", instanceImage))
+			if instanceUserData != "" {
+				data, err := ioutil.ReadFile(instanceUserData)
+				common.Check(err)
+				opts.UserData = string(data)
 			}
 
-			flavors, err := client.CloudProjectFlavorsList(projectID, regionName)
+			opts.Networks, err = instanceCreateNetworks(client)
 			common.Check(err)
 
-			var f *ovh.Flavor
-			for i := range flavors {
-				if flavors[i].Name == instanceFlavor && flavors[i].Region == regionName {
-					f = &flavors[i]
-					break
-				}
+			if instanceGroup != "" {
+				group, err := findInstanceGroup(client, instanceGroup)
+				common.Check(err)
+				opts.GroupID = group.ID
 			}
 
-			if f == nil {
-				common.Check(fmt.Errorf("Flavor %s not found", instanceFlavor))
+			instances, err := client.CloudCreateInstances(projectID, opts, instanceCount)
+			if err != nil && len(instances) > 0 {
+				// show what was created before failing
+				common.FormatOutputDef(instances)
 			}
-
-			sshkeys, err := client.CloudProjectSSHKeyList(projectID)
 			common.Check(err)
 
-			var k *ovh.Sshkey
-			for i := range sshkeys {
-				if sshkeys[i].Name == instanceSSHKey {
-					k = &sshkeys[i]
-					break
+			if common.Wait {
+				for i := range instances {
+					ins, err := client.WaitInstanceStatus(context.Background(), projectID, instances[i].ID, "ACTIVE", common.WaitOptions("Instance "+instances[i].Name)...)
+					common.Check(err)
+					instances[i] = *ins
 				}
 			}
 
-			if k == nil {
-				common.Check(fmt.Errorf("SSH Key %s not found", instanceSSHKey))
+			if instanceCount == 1 {
+				common.FormatOutputDef(instances[0])
+				return
 			}
+			common.FormatOutputDef(instances)
+		},
+	}
 
-			ins, err := client.CloudCreateInstance(projectID, args[0], k.ID, f.ID, img.ID, regionName)
+	cmdProjectInstanceList = &cobra.Command{
+		Use:   "list",
+		Short: "List instances",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
 			common.Check(err)
 
-			common.FormatOutputDef(ins)
+			resolveProject(cmd, client)
+
+			instances, err := client.CloudListInstance(projectID)
+			common.Check(err)
+
+			if regionName != "" {
+				inRegion := []ovh.Instance{}
+				for _, instance := range instances {
+					if instance.Region == regionName {
+						inRegion = append(inRegion, instance)
+					}
+				}
+				instances = inRegion
+			}
+
+			common.FormatOutputDef(instances)
+		},
+	}
+
+	cmdProjectInstanceInfo = &cobra.Command{
+		Use:   "info <instance>",
+		Short: "Show an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			_, instance := instanceFromArgs(cmd, args)
+			common.FormatOutputDef(instance)
 		},
 	}
 
-	cmdProjectInstanceList = &cobra.Command{}
+	cmdProjectInstanceDelete = &cobra.Command{
+		Use:   "delete <instance>",
+		Short: "Delete an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudDeleteInstance(projectID, instance.ID))
+
+			if common.Wait {
+				common.Check(client.WaitInstanceDeleted(context.Background(), projectID, instance.ID, common.WaitOptions("Instance "+instance.Name)...))
+			}
+			fmt.Printf("Instance %s deleted\n", instance.Name)
+		},
+	}
 )
+
+// instanceFromArgs returns a client and the instance named or identified by
+// the only argument, in the project given by the flags
+func instanceFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.Instance) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	instance, err := findInstance(client, args[0])
+	common.Check(err)
+	return client, instance
+}
+
+// instanceCreateNetworks returns the networks of --network, after the public
+// network unless --no-public-network
+func instanceCreateNetworks(client *ovh.Client) ([]ovh.InstanceNetwork, error) {
+	if len(instanceNetworks) == 0 {
+		if instanceNoPublicNetwork {
+			return nil, fmt.Errorf("--no-public-network needs at least a --network")
+		}
+		return nil, nil
+	}
+
+	networks := []ovh.InstanceNetwork{}
+	if !instanceNoPublicNetwork {
+		public, err := client.CloudInfoNetworkPublic(projectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range public {
+			networks = append(networks, ovh.InstanceNetwork{NetworkID: n.ID})
+		}
+	}
+
+	for _, nameOrID := range instanceNetworks {
+		n, err := findNetwork(client, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if n.Type == "public" && !instanceNoPublicNetwork {
+			continue
+		}
+		networks = append(networks, ovh.InstanceNetwork{NetworkID: n.ID})
+	}
+	return networks, nil
+}
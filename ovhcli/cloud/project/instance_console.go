@@ -0,0 +1,149 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectInstance.AddCommand(cmdProjectInstanceSSH)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceConsole)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceLog)
+
+	cmdProjectInstanceSSH.Flags().StringVar(&sshUser, "user", "", "Login user, the default user of the image of the instance if empty")
+	cmdProjectInstanceSSH.Flags().StringVarP(&sshIdentity, "identity", "i", "", "Private key, the one of ~/.ssh matching the SSH key of the instance if empty")
+	cmdProjectInstanceSSH.Flags().BoolVar(&sshIPv6, "ipv6", false, "Connect to the public IPv6 of the instance")
+
+	cmdProjectInstanceLog.Flags().IntVar(&logLines, "lines", 0, "Number of lines to show from the end of the output, all of them if 0")
+}
+
+var (
+	sshUser     string
+	sshIdentity string
+	sshIPv6     bool
+	logLines    int
+
+	cmdProjectInstanceSSH = &cobra.Command{
+		Use:   "ssh <instance> [-- <ssh arguments>]",
+		Short: "Connect to an instance with ssh, given its name or ID",
+		Long: `Connect to an instance with ssh, given its name or ID
+
+The public IP and the login user of the instance are resolved from the API,
+and the private key from the public keys of ~/.ssh. The arguments after --
+are given to ssh, such as a command to run.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			sshArgs := []string{}
+			if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+				args, sshArgs = args[:dash], args[dash:]
+			}
+
+			client, instance := instanceFromArgs(cmd, args)
+			// the list of instances does not detail their image and key
+			instance, err := client.CloudInfoInstance(projectID, instance.ID)
+			common.Check(err)
+
+			ip := instance.PublicIP(sshIPv6)
+			if ip == "" && sshIPv6 && instance.PublicIP(false) != "" {
+				common.Check(fmt.Errorf("Instance %s has no public IPv6", instance.Name))
+			}
+			if ip == "" {
+				common.Check(fmt.Errorf("Instance %s has no public IP yet", instance.Name))
+			}
+
+			user := sshUser
+			if user == "" && instance.Image != nil {
+				user = instance.Image.User
+			}
+			if user == "" {
+				common.Check(fmt.Errorf("Login user of instance %s unknown, set --user: %w", instance.Name, ovh.ErrInvalid))
+			}
+
+			identity := sshIdentity
+			if identity == "" && instance.Sshkey != nil {
+				identity = localPrivateKey(instance.Sshkey)
+			}
+			sshArgs = append([]string{user + "@" + ip}, sshArgs...)
+			if identity != "" {
+				sshArgs = append([]string{"-i", identity}, sshArgs...)
+			}
+
+			ssh := exec.Command("ssh", sshArgs...)
+			ssh.Stdin, ssh.Stdout, ssh.Stderr = os.Stdin, os.Stdout, os.Stderr
+			err = ssh.Run()
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				os.Exit(exitErr.ExitCode())
+			}
+			common.Check(err)
+		},
+	}
+
+	cmdProjectInstanceConsole = &cobra.Command{
+		Use:   "console <instance>",
+		Short: "Show the URL of the VNC console of an instance, given its name or ID. The URL expires quickly",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			vnc, err := client.CloudInstanceVNC(projectID, instance.ID)
+			common.Check(err)
+			common.FormatOutputDef(vnc)
+		},
+	}
+
+	cmdProjectInstanceLog = &cobra.Command{
+		Use:   "log <instance>",
+		Short: "Show the boot console output of an instance, given its name or ID",
+		Long: `Show the boot console output of an instance, given its name or ID
+
+The output is read from the OpenStack compute API of the project, with the
+credentials of a project user set by its openrc file: OS_AUTH_URL, OS_USERNAME,
+OS_PASSWORD and OS_TENANT_ID or OS_PROJECT_ID.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			creds, err := ovh.OpenStackCredentialsFromEnv()
+			common.Check(err)
+
+			client, instance := instanceFromArgs(cmd, args)
+			output, err := client.CloudInstanceConsoleOutput(creds, instance.Region, instance.ID, logLines)
+			common.Check(err)
+			fmt.Print(output)
+		},
+	}
+)
+
+// localPrivateKey returns the private key of ~/.ssh matching sshkey, which is
+// next to its public key, or "" if none does
+func localPrivateKey(sshkey *ovh.Sshkey) string {
+	fingerprint := sshkey.Fingerprint
+	if fingerprint == "" {
+		fingerprint, _ = ovh.SSHKeyFingerprint(sshkey.PublicKey)
+	}
+	home, err := os.UserHomeDir()
+	if fingerprint == "" || err != nil {
+		return ""
+	}
+
+	files, _ := filepath.Glob(filepath.Join(home, ".ssh", "*.pub"))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		for _, key := range ovh.ParseAuthorizedKeys(string(data)) {
+			if f, _ := ovh.SSHKeyFingerprint(key); f == fingerprint {
+				private := strings.TrimSuffix(file, ".pub")
+				if _, err := os.Stat(private); err == nil {
+					return private
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectInstance.AddCommand(cmdProjectInstanceGroup)
+	cmdProjectInstanceGroup.AddCommand(cmdProjectInstanceGroupList)
+	cmdProjectInstanceGroup.AddCommand(cmdProjectInstanceGroupCreate)
+	cmdProjectInstanceGroup.AddCommand(cmdProjectInstanceGroupInfo)
+	cmdProjectInstanceGroup.AddCommand(cmdProjectInstanceGroupDelete)
+	cmdProjectInstanceGroup.AddCommand(cmdProjectInstanceGroupMembers)
+
+	cmdProjectInstanceGroupCreate.Flags().StringVar(&groupPolicy, "policy", ovh.InstanceGroupAntiAffinity, "Placement of the instances, anti-affinity on different hosts or affinity on the same host")
+}
+
+var (
+	groupPolicy string
+
+	cmdProjectInstanceGroup = &cobra.Command{
+		Use:   "group",
+		Short: "Instance groups management, to place instances on different hosts",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectInstanceGroupList = &cobra.Command{
+		Use:   "list",
+		Short: "List instance groups",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			groups, err := client.CloudListInstanceGroups(projectID, regionName)
+			common.Check(err)
+			common.FormatOutputDef(groups)
+		},
+	}
+
+	cmdProjectInstanceGroupCreate = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an instance group. Instances join it at their creation, with --group",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+			if regionName == "" {
+				common.WrongUsage(cmd)
+			}
+
+			group, err := client.CloudCreateInstanceGroup(projectID, ovh.InstanceGroupCreateOpts{Name: args[0], Region: regionName, Policy: groupPolicy})
+			common.Check(err)
+			common.FormatOutputDef(group)
+		},
+	}
+
+	cmdProjectInstanceGroupInfo = &cobra.Command{
+		Use:   "info <group>",
+		Short: "Show an instance group, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			_, group := instanceGroupFromArgs(cmd, args)
+			common.FormatOutputDef(group)
+		},
+	}
+
+	cmdProjectInstanceGroupDelete = &cobra.Command{
+		Use:   "delete <group>",
+		Short: "Delete an instance group, given its name or ID. Its instances are kept",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, group := instanceGroupFromArgs(cmd, args)
+			common.Check(client.CloudDeleteInstanceGroup(projectID, group.ID))
+			fmt.Printf("Instance group %s deleted\n", group.Name)
+		},
+	}
+
+	cmdProjectInstanceGroupMembers = &cobra.Command{
+		Use:   "members <group>",
+		Short: "List the instances of an instance group, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, group := instanceGroupFromArgs(cmd, args)
+			instances, err := client.CloudListInstanceGroupMembers(projectID, group.ID)
+			common.Check(err)
+			common.FormatOutputDef(instances)
+		},
+	}
+)
+
+// instanceGroupFromArgs returns a client and the instance group named or
+// identified by the only argument, in the project given by the flags
+func instanceGroupFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.InstanceGroup) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	group, err := findInstanceGroup(client, args[0])
+	common.Check(err)
+	return client, group
+}
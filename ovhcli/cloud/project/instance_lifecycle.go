@@ -0,0 +1,156 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectInstance.AddCommand(cmdProjectInstanceReboot)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceStart)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceStop)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceShelve)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceUnshelve)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceRescue)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceUnrescue)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceReinstall)
+	cmdProjectInstance.AddCommand(cmdProjectInstanceResize)
+
+	cmdProjectInstanceReboot.Flags().BoolVar(&rebootHard, "hard", false, "Hard reboot, like a power cycle")
+	common.AddWaitFlags(cmdProjectInstanceReboot)
+	cmdProjectInstanceRescue.Flags().StringVar(&rescueImage, "image", "", "Rescue image, by name or ID. Default rescue image if empty")
+	cmdProjectInstanceReinstall.Flags().StringVar(&reinstallImage, "image", "", "Image to install, by name or ID")
+	cmdProjectInstanceReinstall.MarkFlagRequired("image")
+	cmdProjectInstanceResize.Flags().StringVar(&resizeFlavor, "flavor", "", "New flavor, by name or ID")
+	cmdProjectInstanceResize.MarkFlagRequired("flavor")
+}
+
+var (
+	rebootHard     bool
+	rescueImage    string
+	reinstallImage string
+	resizeFlavor   string
+
+	cmdProjectInstanceReboot = &cobra.Command{
+		Use:   "reboot <instance>",
+		Short: "Reboot an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+
+			rebootType := ovh.RebootSoft
+			if rebootHard {
+				rebootType = ovh.RebootHard
+			}
+			common.Check(client.CloudRebootInstance(projectID, instance.ID, rebootType))
+
+			if common.Wait {
+				_, err := client.WaitInstanceRebooted(context.Background(), projectID, instance.ID, common.WaitOptions("Instance "+instance.Name)...)
+				common.Check(err)
+			}
+			fmt.Printf("Instance %s rebooted\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceStart = &cobra.Command{
+		Use:   "start <instance>",
+		Short: "Start a stopped instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudStartInstance(projectID, instance.ID))
+			fmt.Printf("Instance %s started\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceStop = &cobra.Command{
+		Use:   "stop <instance>",
+		Short: "Stop an instance, given its name or ID. A stopped instance is still billed",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudStopInstance(projectID, instance.ID))
+			fmt.Printf("Instance %s stopped\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceShelve = &cobra.Command{
+		Use:   "shelve <instance>",
+		Short: "Shelve an instance, given its name or ID: its disk is saved and its resources released",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudShelveInstance(projectID, instance.ID))
+			fmt.Printf("Instance %s shelved\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceUnshelve = &cobra.Command{
+		Use:   "unshelve <instance>",
+		Short: "Unshelve an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudUnshelveInstance(projectID, instance.ID))
+			fmt.Printf("Instance %s unshelved\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceRescue = &cobra.Command{
+		Use:   "rescue <instance>",
+		Short: "Reboot an instance in rescue mode, given its name or ID, and show the rescue password",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+
+			imageID := ""
+			if rescueImage != "" {
+				img, err := findImage(client, instance.Region, rescueImage)
+				common.Check(err)
+				imageID = img.ID
+			}
+
+			password, err := client.CloudRescueInstance(projectID, instance.ID, imageID)
+			common.Check(err)
+			common.FormatOutputDef(ovh.RescueAdminPassword{AdminPassword: &password})
+		},
+	}
+
+	cmdProjectInstanceUnrescue = &cobra.Command{
+		Use:   "unrescue <instance>",
+		Short: "Reboot an instance in rescue mode on its own disk, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+			common.Check(client.CloudUnrescueInstance(projectID, instance.ID))
+			fmt.Printf("Instance %s out of rescue mode\n", instance.Name)
+		},
+	}
+
+	cmdProjectInstanceReinstall = &cobra.Command{
+		Use:   "reinstall <instance>",
+		Short: "Reinstall an instance with an image, given its name or ID. Its data are lost",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+
+			img, err := findImage(client, instance.Region, reinstallImage)
+			common.Check(err)
+
+			ins, err := client.CloudReinstallInstance(projectID, instance.ID, img.ID)
+			common.Check(err)
+			common.FormatOutputDef(ins)
+		},
+	}
+
+	cmdProjectInstanceResize = &cobra.Command{
+		Use:   "resize <instance>",
+		Short: "Resize an instance to another flavor, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+
+			f, err := findFlavor(client, instance.Region, resizeFlavor)
+			common.Check(err)
+
+			ins, err := client.CloudResizeInstance(projectID, instance.ID, f.ID)
+			common.Check(err)
+			common.FormatOutputDef(ins)
+		},
+	}
+)
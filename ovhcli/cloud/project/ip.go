@@ -0,0 +1,98 @@
+package project
+
+import (
+	"context"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectIP.AddCommand(cmdProjectIPFailover)
+	cmdProjectIPFailover.AddCommand(cmdProjectIPFailoverList)
+	cmdProjectIPFailover.AddCommand(cmdProjectIPFailoverInfo)
+	cmdProjectIPFailover.AddCommand(cmdProjectIPFailoverAttach)
+
+	common.AddWaitFlags(cmdProjectIPFailoverAttach)
+}
+
+var (
+	cmdProjectIP = &cobra.Command{
+		Use:   "ip",
+		Short: "Project IPs management",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectIPFailover = &cobra.Command{
+		Use:   "failover",
+		Short: "Project failover IPs management",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectIPFailoverList = &cobra.Command{
+		Use:   "list",
+		Short: "List failover IPs",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			ips, err := client.CloudListFailoverIPs(projectID)
+			common.Check(err)
+			common.FormatOutputDef(ips)
+		},
+	}
+
+	cmdProjectIPFailoverInfo = &cobra.Command{
+		Use:   "info <ip>",
+		Short: "Show a failover IP, given its address or ID, and the instance it is routed to",
+		Run: func(cmd *cobra.Command, args []string) {
+			_, ip := failoverIPFromArgs(cmd, args, 1)
+			common.FormatOutputDef(ip)
+		},
+	}
+
+	cmdProjectIPFailoverAttach = &cobra.Command{
+		Use:   "attach <ip> <instance>",
+		Short: "Route a failover IP, given its address or ID, to an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, ip := failoverIPFromArgs(cmd, args, 2)
+
+			instance, err := findInstance(client, args[1])
+			common.Check(err)
+
+			ip, err = client.CloudAttachFailoverIP(projectID, ip.ID, instance.ID)
+			common.Check(err)
+
+			if common.Wait {
+				ip, err = client.WaitFailoverIPRouted(context.Background(), projectID, ip.ID, instance.ID, common.WaitOptions("Failover IP "+ip.IP)...)
+				common.Check(err)
+			}
+
+			common.FormatOutputDef(ip)
+		},
+	}
+)
+
+// failoverIPFromArgs returns a client and the failover IP given by the first
+// of n arguments, in the project given by the flags
+func failoverIPFromArgs(cmd *cobra.Command, args []string, n int) (*ovh.Client, *ovh.FailoverIP) {
+	if len(args) != n {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	ip, err := findFailoverIP(client, args[0])
+	common.Check(err)
+	return client, ip
+}
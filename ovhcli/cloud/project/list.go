@@ -1,6 +1,8 @@
 package project
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
@@ -15,22 +17,19 @@ var cmdProjectList = &cobra.Command{
 	Use:   "list",
 	Short: "List all projects: ovhcli project list",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		projects, err := client.CloudProjectsList()
+		common.Check(err)
 
 		if withDetails {
-			projectsComplete := []ovh.Project{}
-			for _, project := range projects {
-				p, e := client.CloudProjectInfoByID(project.ID)
-				common.Check(e)
-				projectsComplete = append(projectsComplete, *p)
-			}
-			projects = projectsComplete
+			projects, err = ovh.FetchDetails(context.Background(), client, projects, func(ctx context.Context, project ovh.Project) (*ovh.Project, error) {
+				return client.CloudProjectInfoByIDCtx(ctx, project.ID)
+			})
+			common.Check(err)
 		}
 
-		common.Check(err)
 		common.FormatOutputDef(projects)
 	},
 }
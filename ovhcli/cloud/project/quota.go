@@ -0,0 +1,32 @@
+package project
+
+import (
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+var cmdProjectQuota = &cobra.Command{
+	Use:   "quota",
+	Short: "Show the limits and the usage of instances, cores, RAM and volumes, by region",
+	Long: `Show the limits and the usage of instances, cores, RAM and volumes, by region
+
+Volumes are in GB. --region shows only one region.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := common.NewClient()
+		common.Check(err)
+
+		resolveProject(cmd, client)
+
+		if regionName != "" {
+			quota, err := client.CloudQuota(projectID, regionName)
+			common.Check(err)
+			common.FormatOutputDef([]ovh.Quota{*quota})
+			return
+		}
+
+		quotas, err := client.CloudListQuotas(projectID)
+		common.Check(err)
+		common.FormatOutputDef(quotas)
+	},
+}
@@ -1,6 +1,8 @@
 package project
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
@@ -25,31 +27,19 @@ var (
 		Use:   "list",
 		Short: "List all regions: ovhcli cloud region list",
 		Run: func(cmd *cobra.Command, args []string) {
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" {
-				common.WrongUsage(cmd)
-			}
+			resolveProject(cmd, client)
 
 			regions, err := client.CloudListRegions(projectID)
 			common.Check(err)
 
 			if withDetails {
-				regionsComplete := []ovh.Region{}
-				for _, region := range regions {
-					r, err := client.CloudInfoRegion(projectID, region.Region)
-					common.Check(err)
-					regionsComplete = append(regionsComplete, *r)
-				}
-				regions = regionsComplete
-
+				regions, err = ovh.FetchDetails(context.Background(), client, regions, func(ctx context.Context, region ovh.Region) (*ovh.Region, error) {
+					return client.CloudInfoRegionCtx(ctx, projectID, region.Region)
+				})
+				common.Check(err)
 			}
 
 			common.FormatOutputDef(regions)
@@ -63,18 +53,10 @@ var (
 			if len(args) == 0 {
 				common.WrongUsage(cmd)
 			}
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" {
-				common.WrongUsage(cmd)
-			}
+			resolveProject(cmd, client)
 
 			r, err := client.CloudInfoRegion(projectID, args[0])
 			common.Check(err)
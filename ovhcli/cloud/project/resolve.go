@@ -0,0 +1,327 @@
+package project
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+// resolveProject sets projectID from the --name flag when given, and exits
+// with the usage of cmd when no project is given
+func resolveProject(cmd *cobra.Command, client *ovh.Client) {
+	if projectName != "" {
+		p, err := client.CloudProjectInfoByName(projectName)
+		common.Check(err)
+		projectID = p.ID
+	}
+
+	if projectID == "" {
+		common.WrongUsage(cmd)
+	}
+}
+
+// latestImagePrefix prefixes the distributions given instead of images, such
+// as "latest:Ubuntu LTS"
+const latestImagePrefix = "latest:"
+
+// findImage returns the image or snapshot of region with the name or the ID
+// nameOrID, or the image of the latest version of a distribution given as
+// latest:<distribution>, snapshots left out
+func findImage(client *ovh.Client, region, nameOrID string) (*ovh.Image, error) {
+	imgs, err := client.CloudProjectImagesList(projectID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(nameOrID, latestImagePrefix) {
+		return ovh.LatestImage(imgs, strings.TrimPrefix(nameOrID, latestImagePrefix))
+	}
+
+	snaps, err := client.CloudProjectSnapshotsList(projectID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	imgs = append(imgs, snaps...)
+
+	for i := range imgs {
+		if (imgs[i].Name == nameOrID || imgs[i].ID == nameOrID) && imgs[i].Region == region {
+			return &imgs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Image %s %w", nameOrID, ovh.ErrNotFound)
+}
+
+// findFlavor returns the flavor of region with the name or the ID nameOrID
+func findFlavor(client *ovh.Client, region, nameOrID string) (*ovh.Flavor, error) {
+	flavors, err := client.CloudProjectFlavorsList(projectID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range flavors {
+		if (flavors[i].Name == nameOrID || flavors[i].ID == nameOrID) && flavors[i].Region == region {
+			return &flavors[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Flavor %s %w", nameOrID, ovh.ErrNotFound)
+}
+
+// findSSHKey returns the SSH key with the name or the ID nameOrID
+func findSSHKey(client *ovh.Client, nameOrID string) (*ovh.Sshkey, error) {
+	sshkeys, err := client.CloudProjectSSHKeyList(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sshkeys {
+		if sshkeys[i].Name == nameOrID || sshkeys[i].ID == nameOrID {
+			return &sshkeys[i], nil
+		}
+	}
+	return nil, fmt.Errorf("SSH Key %s %w", nameOrID, ovh.ErrNotFound)
+}
+
+// findInstance returns the instance with the name or the ID nameOrID, in
+// regionName if set. Names shared by several instances are rejected.
+func findInstance(client *ovh.Client, nameOrID string) (*ovh.Instance, error) {
+	instances, err := client.CloudListInstance(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.Instance
+	for i := range instances {
+		if regionName != "" && instances[i].Region != regionName {
+			continue
+		}
+		if instances[i].ID == nameOrID {
+			return &instances[i], nil
+		}
+		if instances[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several instances are named %s, use an ID or --region", nameOrID)
+			}
+			found = &instances[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Instance %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findNetwork returns the public or private network with the name or the ID nameOrID
+func findNetwork(client *ovh.Client, nameOrID string) (*ovh.Network, error) {
+	networks, err := client.CloudInfoNetworkPrivate(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	public, err := client.CloudInfoNetworkPublic(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	networks = append(networks, public...)
+
+	for i := range networks {
+		if networks[i].Name == nameOrID || networks[i].ID == nameOrID {
+			return &networks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Network %s %w", nameOrID, ovh.ErrNotFound)
+}
+
+// findSnapshot returns the snapshot with the name or the ID nameOrID, in
+// regionName if set. Names shared by several snapshots are rejected.
+func findSnapshot(client *ovh.Client, nameOrID string) (*ovh.Image, error) {
+	snapshots, err := client.CloudProjectSnapshotsList(projectID, regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.Image
+	for i := range snapshots {
+		if snapshots[i].ID == nameOrID {
+			return &snapshots[i], nil
+		}
+		if snapshots[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several snapshots are named %s, use an ID or --region", nameOrID)
+			}
+			found = &snapshots[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Snapshot %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findVolume returns the volume with the name or the ID nameOrID, in
+// regionName if set. Names shared by several volumes are rejected.
+func findVolume(client *ovh.Client, nameOrID string) (*ovh.Volume, error) {
+	volumes, err := client.CloudListVolumes(projectID, regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.Volume
+	for i := range volumes {
+		if volumes[i].ID == nameOrID {
+			return &volumes[i], nil
+		}
+		if volumes[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several volumes are named %s, use an ID or --region", nameOrID)
+			}
+			found = &volumes[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Volume %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findVolumeSnapshot returns the volume snapshot with the name or the ID
+// nameOrID, in regionName if set. Names shared by several snapshots are rejected.
+func findVolumeSnapshot(client *ovh.Client, nameOrID string) (*ovh.VolumeSnapshot, error) {
+	snapshots, err := client.CloudListVolumeSnapshots(projectID, regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.VolumeSnapshot
+	for i := range snapshots {
+		if snapshots[i].ID == nameOrID {
+			return &snapshots[i], nil
+		}
+		if snapshots[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several volume snapshots are named %s, use an ID or --region", nameOrID)
+			}
+			found = &snapshots[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Volume snapshot %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findFailoverIP returns the failover IP with the address, the block or the ID ipOrID
+func findFailoverIP(client *ovh.Client, ipOrID string) (*ovh.FailoverIP, error) {
+	ips, err := client.CloudListFailoverIPs(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ips {
+		if ips[i].ID == ipOrID || ips[i].IP == ipOrID || ips[i].Block == ipOrID {
+			return &ips[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Failover IP %s %w", ipOrID, ovh.ErrNotFound)
+}
+
+// findInstanceGroup returns the instance group with the name or the ID
+// nameOrID, in regionName if set. Names shared by several groups are rejected.
+func findInstanceGroup(client *ovh.Client, nameOrID string) (*ovh.InstanceGroup, error) {
+	groups, err := client.CloudListInstanceGroups(projectID, regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.InstanceGroup
+	for i := range groups {
+		if groups[i].ID == nameOrID {
+			return &groups[i], nil
+		}
+		if groups[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several instance groups are named %s, use an ID or --region", nameOrID)
+			}
+			found = &groups[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Instance group %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findUser returns the user with the ID, the username or the description
+// nameOrID. Descriptions shared by several users are rejected.
+func findUser(client *ovh.Client, nameOrID string) (*ovh.User, error) {
+	users, err := client.CloudProjectUsersList(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.User
+	for i := range users {
+		if strconv.Itoa(users[i].ID) == nameOrID || users[i].Username == nameOrID {
+			return &users[i], nil
+		}
+		if users[i].Description == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several users are described as %s, use an ID or a username", nameOrID)
+			}
+			found = &users[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("User %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
+
+// findRole returns the role of users with the name or the ID nameOrID
+func findRole(client *ovh.Client, nameOrID string) (*ovh.Role, error) {
+	roles, err := client.CloudListRoles(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range roles {
+		if roles[i].Name == nameOrID || roles[i].ID == nameOrID {
+			return &roles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Role %s %w", nameOrID, ovh.ErrNotFound)
+}
+
+// findContainer returns the storage container with the name or the ID
+// nameOrID, in regionName if set. Names shared by several containers are rejected.
+func findContainer(client *ovh.Client, nameOrID string) (*ovh.Container, error) {
+	containers, err := client.CloudListContainers(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ovh.Container
+	for i := range containers {
+		if regionName != "" && containers[i].Region != regionName {
+			continue
+		}
+		if containers[i].ID == nameOrID {
+			return &containers[i], nil
+		}
+		if containers[i].Name == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("Several containers are named %s, use an ID or --region", nameOrID)
+			}
+			found = &containers[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("Container %s %w", nameOrID, ovh.ErrNotFound)
+	}
+	return found, nil
+}
@@ -0,0 +1,167 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectSnapshot.AddCommand(cmdProjectSnapshotList)
+	cmdProjectSnapshot.AddCommand(cmdProjectSnapshotInfo)
+	cmdProjectSnapshot.AddCommand(cmdProjectSnapshotCreate)
+	cmdProjectSnapshot.AddCommand(cmdProjectSnapshotDelete)
+	cmdProjectSnapshot.AddCommand(cmdProjectSnapshotPrune)
+
+	cmdProjectSnapshotCreate.Flags().StringVar(&snapshotName, "snapshot-name", "", "Name of the snapshot. <instance>-<date>-<time> if empty")
+	common.AddWaitFlags(cmdProjectSnapshotCreate)
+
+	cmdProjectSnapshotPrune.Flags().IntVar(&snapshotKeep, "keep", 0, "Number of most recent snapshots to keep")
+	cmdProjectSnapshotPrune.Flags().StringVar(&snapshotInstance, "instance", "", "Prune the snapshots named by snapshot create for this instance, <instance>-<date>-<time>")
+	cmdProjectSnapshotPrune.Flags().StringVar(&snapshotPrefix, "prefix", "", "Prune the snapshots with a name starting with this prefix")
+	cmdProjectSnapshotPrune.Flags().BoolVar(&snapshotDryRun, "dry-run", false, "Show the snapshots to delete, without deleting them")
+	cmdProjectSnapshotPrune.MarkFlagRequired("keep")
+}
+
+var (
+	snapshotName     string
+	snapshotKeep     int
+	snapshotInstance string
+	snapshotPrefix   string
+	snapshotDryRun   bool
+
+	cmdProjectSnapshot = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Project instance snapshots management",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectSnapshotList = &cobra.Command{
+		Use:   "list",
+		Short: "List snapshots",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			snapshots, err := client.CloudProjectSnapshotsList(projectID, regionName)
+			common.Check(err)
+			common.FormatOutputDef(snapshots)
+		},
+	}
+
+	cmdProjectSnapshotInfo = &cobra.Command{
+		Use:   "info <snapshot>",
+		Short: "Show a snapshot, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			_, snapshot := snapshotFromArgs(cmd, args)
+			common.FormatOutputDef(snapshot)
+		},
+	}
+
+	cmdProjectSnapshotCreate = &cobra.Command{
+		Use:   "create <instance>",
+		Short: "Snapshot an instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, instance := instanceFromArgs(cmd, args)
+
+			name := snapshotName
+			if name == "" {
+				name = ovh.InstanceSnapshotName(instance.Name, time.Now())
+			}
+
+			snapshot, err := client.CloudCreateSnapshot(projectID, instance.ID, name)
+			common.Check(err)
+
+			switch {
+			case snapshot.ID == "":
+				fmt.Fprintf(os.Stderr, "Snapshot %s created but not listed yet, see snapshot list\n", snapshot.Name)
+			case common.Wait:
+				snapshot, err = client.WaitSnapshotStatus(context.Background(), projectID, snapshot.ID, "active", common.WaitOptions("Snapshot "+snapshot.Name)...)
+				common.Check(err)
+			}
+
+			common.FormatOutputDef(snapshot)
+		},
+	}
+
+	cmdProjectSnapshotDelete = &cobra.Command{
+		Use:   "delete <snapshot>",
+		Short: "Delete a snapshot, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, snapshot := snapshotFromArgs(cmd, args)
+			common.Check(client.CloudDeleteSnapshot(projectID, snapshot.ID))
+			fmt.Printf("Snapshot %s deleted\n", snapshot.Name)
+		},
+	}
+
+	cmdProjectSnapshotPrune = &cobra.Command{
+		Use:   "prune --keep <n> --instance <instance>|--prefix <prefix>",
+		Short: "Delete the oldest snapshots of an instance, keeping the most recent ones",
+		Long: `Delete the oldest snapshots of an instance, keeping the most recent ones
+
+With --instance, the snapshots are those named by snapshot create,
+<instance>-<date>-<time>. With --prefix, they are all the snapshots with a
+name starting with the prefix. --region limits them to a region.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if snapshotKeep < 1 || (snapshotInstance == "") == (snapshotPrefix == "") {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			match := func(name string) bool {
+				return strings.HasPrefix(name, snapshotPrefix)
+			}
+			if snapshotInstance != "" {
+				match = func(name string) bool {
+					return ovh.IsInstanceSnapshotName(name, snapshotInstance)
+				}
+			}
+
+			if snapshotDryRun {
+				snapshots, err := client.CloudProjectSnapshotsList(projectID, regionName)
+				common.Check(err)
+				common.FormatOutputDef(ovh.SnapshotsToPrune(snapshots, match, snapshotKeep))
+				return
+			}
+
+			deleted, err := client.CloudPruneSnapshots(projectID, regionName, match, snapshotKeep)
+			if err != nil && len(deleted) > 0 {
+				// show what was deleted before failing
+				common.FormatOutputDef(deleted)
+			}
+			common.Check(err)
+			common.FormatOutputDef(deleted)
+		},
+	}
+)
+
+// snapshotFromArgs returns a client and the snapshot named or identified by
+// the only argument, in the project given by the flags
+func snapshotFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.Image) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	snapshot, err := findSnapshot(client, args[0])
+	common.Check(err)
+	return client, snapshot
+}
@@ -0,0 +1,155 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectStorage.AddCommand(cmdProjectStorageList)
+	cmdProjectStorage.AddCommand(cmdProjectStorageCreate)
+	cmdProjectStorage.AddCommand(cmdProjectStorageInfo)
+	cmdProjectStorage.AddCommand(cmdProjectStoragePublic)
+	cmdProjectStorage.AddCommand(cmdProjectStoragePrivate)
+	cmdProjectStorage.AddCommand(cmdProjectStorageStatic)
+	cmdProjectStorage.AddCommand(cmdProjectStorageDelete)
+
+	cmdProjectStorageCreate.Flags().BoolVar(&containerArchive, "archive", false, "Create a cold storage container, cheaper but slow to read")
+	cmdProjectStorageStatic.Flags().BoolVar(&staticDisable, "disable", false, "Stop hosting the static website, the container stays public")
+}
+
+var (
+	containerArchive bool
+	staticDisable    bool
+
+	cmdProjectStorage = &cobra.Command{
+		Use:   "storage",
+		Short: "Object storage management: containers and S3 credentials",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectStorageList = &cobra.Command{
+		Use:   "list",
+		Short: "List storage containers, of --region if set",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			containers, err := client.CloudListContainers(projectID)
+			common.Check(err)
+
+			found := []ovh.Container{}
+			for _, container := range containers {
+				if regionName == "" || container.Region == regionName {
+					found = append(found, container)
+				}
+			}
+			common.FormatOutputDef(found)
+		},
+	}
+
+	cmdProjectStorageCreate = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a private storage container in --region",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+			if regionName == "" {
+				common.WrongUsage(cmd)
+			}
+
+			container, err := client.CloudCreateContainer(projectID, ovh.ContainerCreateOpts{ContainerName: args[0], Region: regionName, Archive: containerArchive})
+			common.Check(err)
+			common.FormatOutputDef(container)
+		},
+	}
+
+	cmdProjectStorageInfo = &cobra.Command{
+		Use:   "info <container>",
+		Short: "Show a storage container, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, container := containerFromArgs(cmd, args)
+			container, err := client.CloudInfoContainer(projectID, container.ID)
+			common.Check(err)
+			common.FormatOutputDef(container)
+		},
+	}
+
+	cmdProjectStoragePublic = &cobra.Command{
+		Use:   "public <container>",
+		Short: "Make a storage container readable by anyone, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, container := containerFromArgs(cmd, args)
+			common.Check(client.CloudSetContainerType(projectID, container.ID, ovh.ContainerPublic))
+			fmt.Printf("Container %s is public\n", container.Name)
+		},
+	}
+
+	cmdProjectStoragePrivate = &cobra.Command{
+		Use:   "private <container>",
+		Short: "Make a storage container only readable with credentials, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, container := containerFromArgs(cmd, args)
+			common.Check(client.CloudSetContainerType(projectID, container.ID, ovh.ContainerPrivate))
+			fmt.Printf("Container %s is private\n", container.Name)
+		},
+	}
+
+	cmdProjectStorageStatic = &cobra.Command{
+		Use:   "static <container>",
+		Short: "Host a static website in a storage container, given its name or ID, and show its URL",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, container := containerFromArgs(cmd, args)
+			if staticDisable {
+				common.Check(client.CloudSetContainerType(projectID, container.ID, ovh.ContainerPublic))
+				fmt.Printf("Container %s no longer hosts a static website\n", container.Name)
+				return
+			}
+
+			common.Check(client.CloudSetContainerType(projectID, container.ID, ovh.ContainerStatic))
+			container, err := client.CloudInfoContainer(projectID, container.ID)
+			common.Check(err)
+			fmt.Printf("Container %s hosts a static website at %s\n", container.Name, container.StaticURL)
+		},
+	}
+
+	cmdProjectStorageDelete = &cobra.Command{
+		Use:   "delete <container>",
+		Short: "Delete an empty storage container, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, container := containerFromArgs(cmd, args)
+			common.Check(client.CloudDeleteContainer(projectID, container.ID))
+			fmt.Printf("Container %s deleted\n", container.Name)
+		},
+	}
+)
+
+// containerFromArgs returns a client and the storage container named or
+// identified by the only argument, in the project given by the flags
+func containerFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.Container) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	container, err := findContainer(client, args[0])
+	common.Check(err)
+	return client, container
+}
@@ -0,0 +1,121 @@
+package project
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectStorage.AddCommand(cmdProjectStorageS3)
+	cmdProjectStorageS3.AddCommand(cmdProjectStorageS3List)
+	cmdProjectStorageS3.AddCommand(cmdProjectStorageS3Create)
+	cmdProjectStorageS3.AddCommand(cmdProjectStorageS3Secret)
+	cmdProjectStorageS3.AddCommand(cmdProjectStorageS3Delete)
+
+	for _, cmd := range []*cobra.Command{cmdProjectStorageS3Create, cmdProjectStorageS3Secret} {
+		cmd.Flags().StringVar(&awsProfile, "aws-profile", "", "Write the credentials as this profile of the AWS credentials file, instead of printing them")
+		cmd.Flags().StringVar(&awsCredentialsFile, "aws-credentials-file", "", "AWS credentials file, $AWS_SHARED_CREDENTIALS_FILE or ~/.aws/credentials if empty")
+	}
+}
+
+var (
+	awsProfile         string
+	awsCredentialsFile string
+
+	cmdProjectStorageS3 = &cobra.Command{
+		Use:   "s3",
+		Short: "S3 credentials management, authenticating users on the S3 API of the object storage",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectStorageS3List = &cobra.Command{
+		Use:   "list <user>",
+		Short: "List the S3 credentials of a user, given its ID, username or description, without their secrets",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+			creds, err := client.CloudListS3Credentials(projectID, user.ID)
+			common.Check(err)
+			common.FormatOutputDef(creds)
+		},
+	}
+
+	cmdProjectStorageS3Create = &cobra.Command{
+		Use:   "create <user>",
+		Short: "Create S3 credentials for a user, given its ID, username or description",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+			creds, err := client.CloudCreateS3Credentials(projectID, user.ID)
+			common.Check(err)
+
+			if awsProfile != "" {
+				writeAWSProfile(creds)
+				return
+			}
+			common.FormatOutputDef(creds)
+		},
+	}
+
+	cmdProjectStorageS3Secret = &cobra.Command{
+		Use:   "secret <user> <access>",
+		Short: "Show the secret of S3 credentials of a user, given the ID, username or description of the user and their access key",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				common.WrongUsage(cmd)
+			}
+			client, user := userFromArgs(cmd, args[:1])
+			secret, err := client.CloudS3CredentialsSecret(projectID, user.ID, args[1])
+			common.Check(err)
+
+			if awsProfile != "" {
+				writeAWSProfile(&ovh.S3Credentials{Access: args[1], Secret: secret})
+				return
+			}
+			fmt.Println(secret)
+		},
+	}
+
+	cmdProjectStorageS3Delete = &cobra.Command{
+		Use:   "delete <user> <access>",
+		Short: "Delete S3 credentials of a user, given the ID, username or description of the user and their access key",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				common.WrongUsage(cmd)
+			}
+			client, user := userFromArgs(cmd, args[:1])
+			common.Check(client.CloudDeleteS3Credentials(projectID, user.ID, args[1]))
+			fmt.Printf("S3 credentials %s deleted\n", args[1])
+		},
+	}
+)
+
+// writeAWSProfile writes creds as the --aws-profile profile of the AWS
+// credentials file, keeping its other profiles
+func writeAWSProfile(creds *ovh.S3Credentials) {
+	file := awsCredentialsFile
+	if file == "" {
+		file = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	if file == "" {
+		home, err := os.UserHomeDir()
+		common.Check(err)
+		file = filepath.Join(home, ".aws", "credentials")
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		common.Check(err)
+	}
+	data, err = ovh.MergeAWSCredentials(data, awsProfile, creds)
+	common.Check(err)
+	common.Check(os.MkdirAll(filepath.Dir(file), 0700))
+	common.Check(ioutil.WriteFile(file, data, 0600))
+	fmt.Printf("Wrote profile %s to %s\n", awsProfile, file)
+}
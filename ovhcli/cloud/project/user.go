@@ -2,9 +2,13 @@ package project
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
-	ovh "github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -13,14 +17,29 @@ import (
 func init() {
 	cmdProjectUser.AddCommand(cmdProjectUserList)
 	cmdProjectUser.AddCommand(cmdProjectCreate)
+	cmdProjectUser.AddCommand(cmdProjectUserDelete)
+	cmdProjectUser.AddCommand(cmdProjectUserRegeneratePassword)
+	cmdProjectUser.AddCommand(cmdProjectUserOpenrc)
+	cmdProjectUser.AddCommand(cmdProjectUserCloudsYAML)
 
-	cmdProjectCreate.Flags().BoolVarP(&envFlag, "env", "", false, "Helps to eval printed values as standard OpenStack environment variables")
+	cmdProjectCreate.Flags().BoolVarP(&envFlag, "env", "", false, "Print the Keystone v3 openrc of the user, in --region or the first region, to eval as standard OpenStack environment variables")
 	cmdProjectCreate.Flags().StringVarP(&descriptionFlag, "description", "", "", "User description")
+
+	cmdProjectUserOpenrc.Flags().BoolVar(&regeneratePassword, "regenerate-password", false, "Set a new password to the user and write it in the files, instead of prompting for it")
+	cmdProjectUserOpenrc.Flags().StringVar(&openrcDir, "output-dir", ".", "Directory of the openrc files")
+
+	cmdProjectUserCloudsYAML.Flags().BoolVar(&regeneratePassword, "regenerate-password", false, "Set a new password to the user and write it in the entries, instead of prompting for it")
+	cmdProjectUserCloudsYAML.Flags().StringVar(&cloudsFile, "file", "", "clouds.yaml file to merge the entries into, such as ~/.config/openstack/clouds.yaml, instead of printing them")
+	cmdProjectUserCloudsYAML.Flags().StringVar(&cloudName, "cloud-name", "ovh", "Prefix of the names of the entries, named <prefix>-<region>")
 }
 
 var (
-	envFlag         bool
-	descriptionFlag string
+	envFlag            bool
+	descriptionFlag    string
+	regeneratePassword bool
+	openrcDir          string
+	cloudsFile         string
+	cloudName          string
 
 	cmdProjectUser = &cobra.Command{
 		Use:   "user",
@@ -34,18 +53,10 @@ var (
 		Use:   "list",
 		Short: "List users",
 		Run: func(cmd *cobra.Command, args []string) {
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" {
-				common.WrongUsage(cmd)
-			}
+			resolveProject(cmd, client)
 
 			users, err := client.CloudProjectUsersList(projectID)
 			common.Check(err)
@@ -58,18 +69,10 @@ var (
 		Use:   "create",
 		Short: "Create user",
 		Run: func(cmd *cobra.Command, args []string) {
-			client, err := ovh.NewClient()
+			client, err := common.NewClient()
 			common.Check(err)
 
-			if projectName != "" {
-				p, err := client.CloudProjectInfoByName(projectName)
-				common.Check(err)
-				projectID = p.ID
-			}
-
-			if projectID == "" {
-				common.WrongUsage(cmd)
-			}
+			resolveProject(cmd, client)
 
 			u, err := client.CloudProjectUserCreate(projectID, descriptionFlag)
 			common.Check(err)
@@ -78,11 +81,11 @@ var (
 				regions, err := client.CloudProjectRegionList(projectID)
 				common.Check(err)
 
-				fmt.Println("export OS_AUTH_URL=https://auth.cloud.ovh.net/v2")
-				fmt.Printf("export OS_REGION_NAME=%s\n", regions[0])
-				fmt.Printf("export OS_TENANT_ID=%s\n", projectID)
-				fmt.Printf("export OS_USERNAME=%s\n", u.Username)
-				fmt.Printf("export OS_PASSWORD=%s\n", u.Password)
+				region := regionName
+				if region == "" && len(regions) > 0 {
+					region = regions[0]
+				}
+				fmt.Print(u.OpenStackCredentials(projectID).Openrc(region))
 				fmt.Printf("# Available regions : %s\n", strings.Join(regions, ", "))
 				return
 			}
@@ -90,4 +93,131 @@ var (
 			common.FormatOutputDef(u)
 		},
 	}
+
+	cmdProjectUserDelete = &cobra.Command{
+		Use:   "delete <user>",
+		Short: "Delete a user, given its ID, username or description",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+			common.Check(client.CloudDeleteUser(projectID, user.ID))
+			fmt.Printf("User %s deleted\n", user.Username)
+		},
+	}
+
+	cmdProjectUserRegeneratePassword = &cobra.Command{
+		Use:   "regenerate-password <user>",
+		Short: "Set a new password to a user, given its ID, username or description, and print it",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+			user, err := client.CloudRegenerateUserPassword(projectID, user.ID)
+			common.Check(err)
+			fmt.Println(user.Password)
+		},
+	}
+
+	cmdProjectUserOpenrc = &cobra.Command{
+		Use:   "openrc <user>",
+		Short: "Write the Keystone v3 openrc files of a user, given its ID, username or description, one per region",
+		Long: `Write the Keystone v3 openrc files of a user, given its ID, username or description, one per region
+
+The files are named openrc-<region>.sh. With --region, the openrc file of the
+region is printed instead. The password of a user is only known at its
+creation, so the files prompt for it when sourced, unless a new one is set
+with --regenerate-password.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+			creds := userCredentials(client, user)
+
+			if regionName != "" {
+				fmt.Print(creds.Openrc(regionName))
+				return
+			}
+
+			regions, err := client.CloudProjectRegionList(projectID)
+			common.Check(err)
+			for _, region := range regions {
+				file := filepath.Join(openrcDir, fmt.Sprintf("openrc-%s.sh", region))
+				common.Check(ioutil.WriteFile(file, []byte(creds.Openrc(region)), 0600))
+				fmt.Printf("Wrote %s\n", file)
+			}
+		},
+	}
+
+	cmdProjectUserCloudsYAML = &cobra.Command{
+		Use:   "clouds-yaml <user>",
+		Short: "Print the clouds.yaml entries of a user, given its ID, username or description, one per region",
+		Long: `Print the clouds.yaml entries of a user, given its ID, username or description, one per region
+
+The entries are named <prefix>-<region>, in --region only if set. With --file,
+they are merged into the file, replacing the entries of the same names and
+keeping the others. The password of a user is only known at its creation, so
+the OpenStack clients prompt for it, unless a new one is set with
+--regenerate-password.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, user := userFromArgs(cmd, args)
+
+			regions := []string{regionName}
+			if regionName == "" {
+				var err error
+				regions, err = client.CloudProjectRegionList(projectID)
+				common.Check(err)
+			}
+
+			creds := userCredentials(client, user)
+			clouds := map[string]ovh.CloudsYAMLCloud{}
+			names := []string{}
+			for _, region := range regions {
+				name := cloudName + "-" + region
+				clouds[name] = creds.CloudsYAMLCloud(region)
+				names = append(names, name)
+			}
+
+			if cloudsFile == "" {
+				data, err := ovh.MergeCloudsYAML(nil, clouds)
+				common.Check(err)
+				fmt.Print(string(data))
+				return
+			}
+
+			data, err := ioutil.ReadFile(cloudsFile)
+			if err != nil && !os.IsNotExist(err) {
+				common.Check(err)
+			}
+			data, err = ovh.MergeCloudsYAML(data, clouds)
+			common.Check(err)
+			common.Check(os.MkdirAll(filepath.Dir(cloudsFile), 0700))
+			common.Check(ioutil.WriteFile(cloudsFile, data, 0600))
+
+			sort.Strings(names)
+			fmt.Printf("Wrote %s to %s\n", strings.Join(names, ", "), cloudsFile)
+		},
+	}
 )
+
+// userFromArgs returns a client and the user identified, named or described
+// by the only argument, in the project given by the flags
+func userFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.User) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	user, err := findUser(client, args[0])
+	common.Check(err)
+	return client, user
+}
+
+// userCredentials returns the OpenStack credentials of user, with a new
+// password if --regenerate-password is set
+func userCredentials(client *ovh.Client, user *ovh.User) *ovh.OpenStackCredentials {
+	if regeneratePassword {
+		var err error
+		user, err = client.CloudRegenerateUserPassword(projectID, user.ID)
+		common.Check(err)
+	}
+	return user.OpenStackCredentials(projectID)
+}
@@ -0,0 +1,84 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectUser.AddCommand(cmdProjectUserRole)
+	cmdProjectUserRole.AddCommand(cmdProjectUserRoleList)
+	cmdProjectUserRole.AddCommand(cmdProjectUserRoleAdd)
+	cmdProjectUserRole.AddCommand(cmdProjectUserRoleRemove)
+}
+
+var (
+	cmdProjectUserRole = &cobra.Command{
+		Use:   "role",
+		Short: "User roles management, granting users permissions on the OpenStack APIs",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectUserRoleList = &cobra.Command{
+		Use:   "list [<user>]",
+		Short: "List the roles of a user, given its ID, username or description, or the roles users can be given",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 1 {
+				client, user := userFromArgs(cmd, args)
+				roles, err := client.CloudListUserRoles(projectID, user.ID)
+				common.Check(err)
+				common.FormatOutputDef(roles)
+				return
+			}
+			if len(args) != 0 {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			roles, err := client.CloudListRoles(projectID)
+			common.Check(err)
+			common.FormatOutputDef(roles)
+		},
+	}
+
+	cmdProjectUserRoleAdd = &cobra.Command{
+		Use:   "add <user> <role>",
+		Short: "Give a role to a user, given their names or IDs",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				common.WrongUsage(cmd)
+			}
+			client, user := userFromArgs(cmd, args[:1])
+			role, err := findRole(client, args[1])
+			common.Check(err)
+
+			_, err = client.CloudAddUserRole(projectID, user.ID, role.ID)
+			common.Check(err)
+			fmt.Printf("Role %s given to user %s\n", role.Name, user.Username)
+		},
+	}
+
+	cmdProjectUserRoleRemove = &cobra.Command{
+		Use:   "remove <user> <role>",
+		Short: "Take a role back from a user, given their names or IDs",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				common.WrongUsage(cmd)
+			}
+			client, user := userFromArgs(cmd, args[:1])
+			role, err := findRole(client, args[1])
+			common.Check(err)
+
+			common.Check(client.CloudRemoveUserRole(projectID, user.ID, role.ID))
+			fmt.Printf("Role %s removed from user %s\n", role.Name, user.Username)
+		},
+	}
+)
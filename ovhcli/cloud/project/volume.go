@@ -0,0 +1,286 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdProjectVolume.AddCommand(cmdProjectVolumeList)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeInfo)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeCreate)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeUpdate)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeDelete)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeAttach)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeDetach)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeUpsize)
+	cmdProjectVolume.AddCommand(cmdProjectVolumeSnapshot)
+	cmdProjectVolumeSnapshot.AddCommand(cmdProjectVolumeSnapshotList)
+	cmdProjectVolumeSnapshot.AddCommand(cmdProjectVolumeSnapshotCreate)
+	cmdProjectVolumeSnapshot.AddCommand(cmdProjectVolumeSnapshotDelete)
+
+	cmdProjectVolumeCreate.Flags().IntVar(&volumeSize, "size", 0, "Size in GB, 10 GB at least")
+	cmdProjectVolumeCreate.Flags().StringVar(&volumeType, "type", ovh.VolumeClassic, "Type of volume, classic or high-speed")
+	cmdProjectVolumeCreate.Flags().StringVar(&volumeDescription, "description", "", "Description of the volume")
+	cmdProjectVolumeCreate.Flags().StringVar(&volumeImage, "image", "", "Image making a bootable volume, by name or ID")
+	cmdProjectVolumeCreate.Flags().StringVar(&volumeSnapshot, "from-snapshot", "", "Volume snapshot to restore, by name or ID")
+	cmdProjectVolumeCreate.MarkFlagRequired("size")
+	common.AddWaitFlags(cmdProjectVolumeCreate)
+
+	cmdProjectVolumeUpdate.Flags().StringVar(&volumeName, "volume-name", "", "New name of the volume")
+	cmdProjectVolumeUpdate.Flags().StringVar(&volumeDescription, "description", "", "New description of the volume")
+
+	common.AddWaitFlags(cmdProjectVolumeAttach)
+	common.AddWaitFlags(cmdProjectVolumeDetach)
+
+	cmdProjectVolumeUpsize.Flags().IntVar(&volumeSize, "size", 0, "New size in GB, larger than the current one")
+	cmdProjectVolumeUpsize.MarkFlagRequired("size")
+
+	cmdProjectVolumeSnapshotCreate.Flags().StringVar(&volumeName, "snapshot-name", "", "Name of the snapshot")
+	cmdProjectVolumeSnapshotCreate.Flags().StringVar(&volumeDescription, "description", "", "Description of the snapshot")
+}
+
+var (
+	volumeName        string
+	volumeSize        int
+	volumeType        string
+	volumeDescription string
+	volumeImage       string
+	volumeSnapshot    string
+
+	cmdProjectVolume = &cobra.Command{
+		Use:   "volume",
+		Short: "Project block storage volumes management",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectVolumeList = &cobra.Command{
+		Use:   "list",
+		Short: "List volumes",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			volumes, err := client.CloudListVolumes(projectID, regionName)
+			common.Check(err)
+			common.FormatOutputDef(volumes)
+		},
+	}
+
+	cmdProjectVolumeInfo = &cobra.Command{
+		Use:   "info <volume>",
+		Short: "Show a volume, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			_, volume := volumeFromArgs(cmd, args)
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeCreate = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a volume",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+			if regionName == "" {
+				common.WrongUsage(cmd)
+			}
+
+			opts := ovh.VolumeCreateOpts{
+				Name:        args[0],
+				Description: volumeDescription,
+				Region:      regionName,
+				Size:        volumeSize,
+				Type:        volumeType,
+			}
+
+			if volumeImage != "" {
+				img, err := findImage(client, regionName, volumeImage)
+				common.Check(err)
+				opts.ImageID = img.ID
+			}
+
+			if volumeSnapshot != "" {
+				snapshot, err := findVolumeSnapshot(client, volumeSnapshot)
+				common.Check(err)
+				opts.SnapshotID = snapshot.ID
+			}
+
+			volume, err := client.CloudCreateVolume(projectID, opts)
+			common.Check(err)
+
+			if common.Wait {
+				volume, err = client.WaitVolumeStatus(context.Background(), projectID, volume.ID, "available", common.WaitOptions("Volume "+volume.Name)...)
+				common.Check(err)
+			}
+
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeUpdate = &cobra.Command{
+		Use:   "update <volume>",
+		Short: "Rename a volume or change its description, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			if volumeName == "" && volumeDescription == "" {
+				common.WrongUsage(cmd)
+			}
+
+			client, volume := volumeFromArgs(cmd, args)
+			volume, err := client.CloudUpdateVolume(projectID, volume.ID, ovh.VolumeUpdateReq{Name: volumeName, Description: volumeDescription})
+			common.Check(err)
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeDelete = &cobra.Command{
+		Use:   "delete <volume>",
+		Short: "Delete a detached volume, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, volume := volumeFromArgs(cmd, args)
+			common.Check(client.CloudDeleteVolume(projectID, volume.ID))
+			fmt.Printf("Volume %s deleted\n", volume.Name)
+		},
+	}
+
+	cmdProjectVolumeAttach = &cobra.Command{
+		Use:   "attach <volume> <instance>",
+		Short: "Attach a volume to an instance, given their names or IDs",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				common.WrongUsage(cmd)
+			}
+
+			client, volume := volumeFromArgs(cmd, args[:1])
+			instance, err := findInstance(client, args[1])
+			common.Check(err)
+
+			volume, err = client.CloudAttachVolume(projectID, volume.ID, instance.ID)
+			common.Check(err)
+
+			if common.Wait {
+				volume, err = client.WaitVolumeStatus(context.Background(), projectID, volume.ID, "in-use", common.WaitOptions("Volume "+volume.Name)...)
+				common.Check(err)
+			}
+
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeDetach = &cobra.Command{
+		Use:   "detach <volume>",
+		Short: "Detach a volume from its instance, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, volume := volumeFromArgs(cmd, args)
+			if len(volume.AttachedTo) == 0 {
+				common.Check(fmt.Errorf("Volume %s is not attached", volume.Name))
+			}
+
+			volume, err := client.CloudDetachVolume(projectID, volume.ID, volume.AttachedTo[0])
+			common.Check(err)
+
+			if common.Wait {
+				volume, err = client.WaitVolumeStatus(context.Background(), projectID, volume.ID, "available", common.WaitOptions("Volume "+volume.Name)...)
+				common.Check(err)
+			}
+
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeUpsize = &cobra.Command{
+		Use:   "upsize <volume>",
+		Short: "Extend a volume, given its name or ID. Its file system must be extended afterwards",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, volume := volumeFromArgs(cmd, args)
+			volume, err := client.CloudUpsizeVolume(projectID, volume.ID, volumeSize)
+			common.Check(err)
+			common.FormatOutputDef(volume)
+		},
+	}
+
+	cmdProjectVolumeSnapshot = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Volume snapshots management",
+		Run: func(cmd *cobra.Command, args []string) {
+			common.WrongUsage(cmd)
+		},
+	}
+
+	cmdProjectVolumeSnapshotList = &cobra.Command{
+		Use:   "list",
+		Short: "List volume snapshots",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			snapshots, err := client.CloudListVolumeSnapshots(projectID, regionName)
+			common.Check(err)
+			common.FormatOutputDef(snapshots)
+		},
+	}
+
+	cmdProjectVolumeSnapshotCreate = &cobra.Command{
+		Use:   "create <volume>",
+		Short: "Snapshot a volume, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, volume := volumeFromArgs(cmd, args)
+			snapshot, err := client.CloudCreateVolumeSnapshot(projectID, volume.ID, ovh.VolumeSnapshotReq{Name: volumeName, Description: volumeDescription})
+			common.Check(err)
+			common.FormatOutputDef(snapshot)
+		},
+	}
+
+	cmdProjectVolumeSnapshotDelete = &cobra.Command{
+		Use:   "delete <snapshot>",
+		Short: "Delete a volume snapshot, given its name or ID",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				common.WrongUsage(cmd)
+			}
+
+			client, err := common.NewClient()
+			common.Check(err)
+
+			resolveProject(cmd, client)
+
+			snapshot, err := findVolumeSnapshot(client, args[0])
+			common.Check(err)
+			common.Check(client.CloudDeleteVolumeSnapshot(projectID, snapshot.ID))
+			fmt.Printf("Volume snapshot %s deleted\n", snapshot.Name)
+		},
+	}
+)
+
+// volumeFromArgs returns a client and the volume named or identified by the
+// only argument, in the project given by the flags
+func volumeFromArgs(cmd *cobra.Command, args []string) (*ovh.Client, *ovh.Volume) {
+	if len(args) != 1 {
+		common.WrongUsage(cmd)
+	}
+
+	client, err := common.NewClient()
+	common.Check(err)
+
+	resolveProject(cmd, client)
+
+	volume, err := findVolume(client, args[0])
+	common.Check(err)
+	return client, volume
+}
@@ -6,6 +6,8 @@ func init() {
 	Cmd.AddCommand(cmdCloudSSHKeyList)
 	Cmd.AddCommand(cmdCloudSSHKeyCreate)
 	Cmd.AddCommand(cmdCloudSSHKeyDelete)
+	Cmd.AddCommand(cmdCloudSSHKeyImport)
+	Cmd.AddCommand(cmdCloudSSHKeySync)
 
 }
 
@@ -1,7 +1,6 @@
 package sshkey
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -9,18 +8,22 @@ import (
 
 func init() {
 	cmdCloudSSHKeyCreate.PersistentFlags().StringVarP(&projectID, "projectID", "", "", "Your ID Project")
-	cmdCloudSSHKeyCreate.PersistentFlags().StringVarP(&pubkeyID, "pubkeyID", "", "", "Your sshkey ID to put")
+	cmdCloudSSHKeyCreate.PersistentFlags().StringVarP(&publicKey, "publicKey", "", "", "Your public SSH key, such as ssh-ed25519 AAAA...")
+	cmdCloudSSHKeyCreate.PersistentFlags().StringVarP(&publicKey, "pubkeyID", "", "", "Your sshkey ID to put")
+	cmdCloudSSHKeyCreate.PersistentFlags().MarkDeprecated("pubkeyID", "use --publicKey, or the import command")
 	cmdCloudSSHKeyCreate.PersistentFlags().StringVarP(&name, "name", "", "", "Your sshkey name to put")
 }
 
+var publicKey string
+
 var cmdCloudSSHKeyCreate = &cobra.Command{
 	Use:   "create",
 	Short: "Create Cloud ssh key: ovhcli cloud sshkey create",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
-		s, err := client.CloudProjectSSHKeyCreate(projectID, pubkeyID, name)
+		s, err := client.CloudProjectSSHKeyCreate(projectID, publicKey, name)
 		common.Check(err)
 		common.FormatOutputDef(s)
 	},
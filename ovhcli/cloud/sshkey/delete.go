@@ -3,7 +3,6 @@ package sshkey
 import (
 	"fmt"
 
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -19,7 +18,7 @@ var cmdCloudSSHKeyDelete = &cobra.Command{
 	Short: "Delete Cloud SSH key: ovhcli cloud sshkey delete",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		err = client.CloudProjectSSHKeyDelete(projectID, pubkeyID)
@@ -0,0 +1,89 @@
+package sshkey
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdCloudSSHKeyImport.Flags().StringVarP(&projectID, "projectID", "", "", "Your ID Project")
+	cmdCloudSSHKeyImport.Flags().StringVarP(&name, "name", "", "", "Name of the key, its comment or file name by default")
+	cmdCloudSSHKeyImport.Flags().BoolVarP(&fromAgent, "from-agent", "", false, "Import the keys of the ssh agent, listed by ssh-add -L")
+}
+
+var fromAgent bool
+
+var cmdCloudSSHKeyImport = &cobra.Command{
+	Use:   "import [<file.pub>...]",
+	Short: "Import public SSH keys, skipping keys already uploaded: ovhcli cloud sshkey import ~/.ssh/id_ed25519.pub",
+	Run: func(cmd *cobra.Command, args []string) {
+		if projectID == "" || (len(args) == 0) == !fromAgent {
+			common.WrongUsage(cmd)
+		}
+
+		keys := []ovh.SshkeyReq{}
+		for _, file := range args {
+			data, err := ioutil.ReadFile(file)
+			common.Check(err)
+			keys = append(keys, commentName(keyringKeys(strings.TrimSuffix(filepath.Base(file), ".pub"), string(data)))...)
+		}
+		if fromAgent {
+			out, err := exec.Command("ssh-add", "-L").Output()
+			common.Check(err)
+			keys = append(keys, commentName(keyringKeys("agent", string(out)))...)
+		}
+
+		if name != "" {
+			if len(keys) != 1 {
+				common.Check(fmt.Errorf("--name needs a single key, got %d", len(keys)))
+			}
+			keys[0].Name = name
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+
+		for _, key := range keys {
+			sshkey, created, err := client.CloudImportSSHKey(projectID, key.Name, key.PublicKey)
+			common.Check(err)
+			if created {
+				fmt.Printf("Public SSH key %s uploaded: %s\n", sshkey.Name, sshkey.Fingerprint)
+			} else {
+				fmt.Printf("Public SSH key %s already uploaded as %s, skipped\n", key.Name, sshkey.Name)
+			}
+		}
+	},
+}
+
+// keyringKeys returns the public keys of an authorized_keys content, named
+// after base, suffixed with their rank if there are several
+func keyringKeys(base, data string) []ovh.SshkeyReq {
+	lines := ovh.ParseAuthorizedKeys(data)
+	keys := []ovh.SshkeyReq{}
+	for i, line := range lines {
+		key := ovh.SshkeyReq{Name: base, PublicKey: line}
+		if len(lines) > 1 {
+			key.Name = fmt.Sprintf("%s-%d", base, i+1)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// commentName renames keys after their comment, such as alice@laptop
+func commentName(keys []ovh.SshkeyReq) []ovh.SshkeyReq {
+	for i, key := range keys {
+		if fields := strings.Fields(key.PublicKey); len(fields) > 2 {
+			keys[i].Name = strings.Join(fields[2:], " ")
+		}
+	}
+	return keys
+}
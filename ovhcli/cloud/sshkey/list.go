@@ -1,6 +1,8 @@
 package sshkey
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 	"github.com/spf13/cobra"
@@ -14,21 +16,15 @@ var cmdCloudSSHKeyList = &cobra.Command{
 	Use:   "list",
 	Short: "List all ssk keys: ovhcli cloud sshkey list",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		sshkeys, err := client.CloudProjectSSHKeyList(projectID)
 		common.Check(err)
 
-		sshkeysComplete := []ovh.Sshkey{}
-		for _, sshkey := range sshkeys {
-			s, err := client.CloudProjectSSHKeyInfo(projectID, sshkey.ID)
-			common.Check(err)
-			sshkeysComplete = append(sshkeysComplete, *s)
-		}
-
-		sshkeys = sshkeysComplete
-
+		sshkeys, err = ovh.FetchDetails(context.Background(), client, sshkeys, func(ctx context.Context, sshkey ovh.Sshkey) (*ovh.Sshkey, error) {
+			return client.CloudProjectSSHKeyInfoCtx(ctx, projectID, sshkey.ID)
+		})
 		common.Check(err)
 		common.FormatOutputDef(sshkeys)
 	},
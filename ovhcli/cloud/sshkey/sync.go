@@ -0,0 +1,65 @@
+package sshkey
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/admdwrf/ovhcli"
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdCloudSSHKeySync.Flags().StringVarP(&projectID, "projectID", "", "", "Your ID Project")
+	cmdCloudSSHKeySync.Flags().BoolVarP(&allProjects, "all-projects", "", false, "Sync every project of the account")
+	cmdCloudSSHKeySync.Flags().BoolVarP(&syncOpts.Remove, "remove", "", false, "Remove the keys which are not in the keyring, and the extra copies of the others")
+	cmdCloudSSHKeySync.Flags().BoolVarP(&syncOpts.DryRun, "dry-run", "", false, "Show the changes without applying them")
+}
+
+var (
+	allProjects bool
+	syncOpts    ovh.SSHKeySyncOpts
+)
+
+var cmdCloudSSHKeySync = &cobra.Command{
+	Use:   "sync <keyring-dir>",
+	Short: "Upload the *.pub keys of a keyring directory, named after their files: ovhcli cloud sshkey sync ./keyring --all-projects",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 || (projectID == "") == !allProjects {
+			common.WrongUsage(cmd)
+		}
+
+		files, err := filepath.Glob(filepath.Join(args[0], "*.pub"))
+		common.Check(err)
+		keys := []ovh.SshkeyReq{}
+		for _, file := range files {
+			data, err := ioutil.ReadFile(file)
+			common.Check(err)
+			keys = append(keys, keyringKeys(strings.TrimSuffix(filepath.Base(file), ".pub"), string(data))...)
+		}
+		if len(keys) == 0 && syncOpts.Remove {
+			// an empty or mistyped directory would remove every key
+			common.Check(fmt.Errorf("No public key in %s: %w", args[0], ovh.ErrInvalid))
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+
+		projects := []ovh.Project{{ID: projectID}}
+		if allProjects {
+			projects, err = client.CloudProjectsList()
+			common.Check(err)
+		}
+
+		actions := []ovh.SSHKeySyncAction{}
+		for _, project := range projects {
+			projectActions, err := client.CloudSyncSSHKeys(project.ID, keys, syncOpts)
+			common.Check(err)
+			actions = append(actions, projectActions...)
+		}
+		common.FormatOutputDef(actions)
+	},
+}
@@ -1,32 +1,132 @@
 package common
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 
+	ovh "github.com/admdwrf/ovhcli"
 	"github.com/spf13/cobra"
 )
 
-// Check checks e and panic if not nil
+// Exit codes of the CLI, per category of error
+const (
+	ExitError       = 1  // any other error
+	ExitUsage       = 2  // wrong usage of a command
+	ExitNotFound    = 3  // missing resource
+	ExitAuth        = 4  // invalid or expired keys
+	ExitForbidden   = 5  // request not allowed to the account
+	ExitQuota       = 6  // quota exceeded
+	ExitInvalid     = 7  // invalid request
+	ExitConflict    = 8  // conflict with the state of a resource
+	ExitRateLimited = 9  // rate limit reached
+	ExitServer      = 10 // server error
+	ExitNetwork     = 11 // the API could not be reached
+	ExitTimeout     = 12 // the command timed out
+)
+
+// errorCategories are the categories of errors, in the order they are checked
+var errorCategories = []struct {
+	err  error
+	name string
+	code int
+}{
+	{ovh.ErrNotFound, "not_found", ExitNotFound},
+	{ovh.ErrAuth, "auth", ExitAuth},
+	{ovh.ErrForbidden, "forbidden", ExitForbidden},
+	{ovh.ErrQuotaExceeded, "quota_exceeded", ExitQuota},
+	{ovh.ErrInvalid, "invalid", ExitInvalid},
+	{ovh.ErrConflict, "conflict", ExitConflict},
+	{ovh.ErrRateLimited, "rate_limited", ExitRateLimited},
+	{ovh.ErrServer, "server", ExitServer},
+	{context.DeadlineExceeded, "timeout", ExitTimeout},
+}
+
+// ErrorOutput is the JSON error written on stderr with --format json
+type ErrorOutput struct {
+	Message   string `json:"message"`
+	Category  string `json:"category"`
+	ExitCode  int    `json:"exitCode"`
+	Status    int    `json:"status,omitempty"`
+	Class     string `json:"class,omitempty"`
+	ErrorCode string `json:"errorCode,omitempty"`
+	QueryID   string `json:"queryId,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Path      string `json:"path,omitempty"`
+}
+
+// NewErrorOutput describes err, with its category and exit code
+func NewErrorOutput(err error) ErrorOutput {
+	out := ErrorOutput{Message: err.Error(), Category: "error", ExitCode: ExitError}
+
+	var apiErr *ovh.Error
+	if errors.As(err, &apiErr) {
+		out.Message = apiErr.Message
+		out.Status = apiErr.Code
+		out.Class = apiErr.Class
+		out.ErrorCode = apiErr.ErrorCode
+		out.QueryID = apiErr.QueryID
+		out.Method = apiErr.Method
+		out.Path = apiErr.Path
+	}
+
+	for _, category := range errorCategories {
+		if errors.Is(err, category.err) {
+			out.Category, out.ExitCode = category.name, category.code
+			return out
+		}
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	if errors.As(err, &netErr) || errors.As(err, &urlErr) {
+		out.Category, out.ExitCode = "network", ExitNetwork
+	}
+	return out
+}
+
+// Check checks e and exits with the exit code of its category if not nil.
+// The error is written on stderr, as JSON with --format json.
 func Check(err error) {
-	if err != nil {
-		if Verbose {
-			panic(err)
+	if err == nil {
+		return
+	}
+	out := NewErrorOutput(err)
+
+	if Format == "json" {
+		data, _ := json.MarshalIndent(out, "", "  ")
+		fmt.Fprintln(os.Stderr, string(data))
+		os.Exit(out.ExitCode)
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+	if Verbose {
+		if out.Class != "" {
+			fmt.Fprintf(os.Stderr, "Class: %s\n", out.Class)
+		}
+		if out.QueryID != "" {
+			fmt.Fprintf(os.Stderr, "Query ID: %s\n", out.QueryID)
+		}
+		if out.Path != "" {
+			fmt.Fprintf(os.Stderr, "Request: %s %s\n", out.Method, out.Path)
 		}
-		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
-		os.Exit(1)
 	}
+	os.Exit(out.ExitCode)
 }
 
 // Exit func display an error message on stderr and exit 1
 func Exit(format string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, format, args...)
-	os.Exit(1)
+	os.Exit(ExitError)
 }
 
-// WrongUsage display a wrong usage error, shows the help and exit 1
+// WrongUsage display a wrong usage error, shows the help and exit 2
 func WrongUsage(cmd *cobra.Command) {
 	fmt.Fprintln(os.Stderr, "Error: Wrong usage")
 	cmd.Help()
-	os.Exit(1)
+	os.Exit(ExitUsage)
 }
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+func TestNewErrorOutput(t *testing.T) {
+	notFound := &ovh.Error{
+		APIError: &govh.APIError{Code: 404, Message: "This instance does not exist", QueryID: "EU.ext-1"},
+		Class:    "Client::NotFound",
+		Method:   "GET",
+		Path:     "/cloud/project/p1/instance/i1",
+	}
+	out := NewErrorOutput(fmt.Errorf("instance web-1: %w", notFound))
+	expected := ErrorOutput{
+		Message:  "This instance does not exist",
+		Category: "not_found",
+		ExitCode: ExitNotFound,
+		Status:   404,
+		Class:    "Client::NotFound",
+		QueryID:  "EU.ext-1",
+		Method:   "GET",
+		Path:     "/cloud/project/p1/instance/i1",
+	}
+	if out != expected {
+		t.Fatalf("expected %+v, got %+v", expected, out)
+	}
+
+	tests := []struct {
+		err      error
+		category string
+		code     int
+	}{
+		{&ovh.Error{APIError: &govh.APIError{Code: 403}, ErrorCode: "INVALID_CREDENTIAL"}, "auth", ExitAuth},
+		{&ovh.Error{APIError: &govh.APIError{Code: 403, Message: "Quota exceeded"}, Class: "Client::Forbidden::QuotaExceeded"}, "quota_exceeded", ExitQuota},
+		{&ovh.Error{APIError: &govh.APIError{Code: 502}}, "server", ExitServer},
+		{&url.Error{Op: "Get", URL: "https://eu.api.ovh.com/1.0/vrack", Err: errors.New("connection refused")}, "network", ExitNetwork},
+		{context.DeadlineExceeded, "timeout", ExitTimeout},
+		{errors.New("boom"), "error", ExitError},
+	}
+	for _, test := range tests {
+		if out := NewErrorOutput(test.err); out.Category != test.category || out.ExitCode != test.code {
+			t.Errorf("%v: expected %s (%d), got %s (%d)", test.err, test.category, test.code, out.Category, out.ExitCode)
+		}
+	}
+}
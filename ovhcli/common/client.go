@@ -0,0 +1,16 @@
+package common
+
+import (
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// NewClient returns a client for the profile selected with --profile
+func NewClient() (*ovh.Client, error) {
+	return ovh.NewClientWithProfile(Profile,
+		ovh.WithConcurrency(Concurrency),
+		ovh.WithRetries(Retries),
+		ovh.WithRetryMaxWait(RetryMaxWait),
+		ovh.WithRecord(Record),
+		ovh.WithReplay(Replay),
+	)
+}
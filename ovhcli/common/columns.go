@@ -0,0 +1,49 @@
+package common
+
+import (
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// default columns of the lists of resources
+func init() {
+	RegisterColumns(ovh.Project{}, "NAME=description,ID=project_id,status,CREATED=creationDate")
+	RegisterColumns(ovh.Region{}, "region,name,status,CONTINENT=continentCode,LOCATION=datacenterLocation")
+	RegisterColumns(ovh.Instance{}, "name,id,status,region,FLAVOR=flavor.name,IPS=ipAddresses[*].ip")
+	RegisterColumns(ovh.InstanceGroup{}, "name,id,region,POLICY=type,INSTANCES=instance_ids[*]")
+	RegisterColumns(ovh.Image{}, "name,id,region,OS=type,visibility,status,CREATED=creationDate")
+	RegisterColumns(ovh.Flavor{}, "name,id,region,VCPUS=vcpus,RAM=ram,DISK=disk,OS=osType")
+	RegisterColumns(ovh.FlavorPrice{}, "name,id,region,VCPUS=vcpus,RAM=ram,DISK=disk,OS=osType,HOURLY=hourlyPrice.text,MONTHLY=monthlyPrice.text")
+	RegisterColumns(ovh.Sshkey{}, "name,id,FINGERPRINT=fingerPrint,regions[*]")
+	RegisterColumns(ovh.SSHKeySyncAction{}, "PROJECT=projectId,action,name,id,FINGERPRINT=fingerPrint")
+	RegisterColumns(ovh.Network{}, "name,id,type,VLAN=vlanId,status,REGIONS=regions[*].region")
+	RegisterColumns(ovh.Subnet{}, "id,cidr,GATEWAY=gatewayIp,REGIONS=ipPools[*].region,START=ipPools[*].start,END=ipPools[*].end")
+	RegisterColumns(ovh.FailoverIP{}, "ip,id,ROUTEDTO=routedTo,status,geoloc,CONTINENT=continentCode")
+	RegisterColumns(ovh.Volume{}, "name,id,region,type,SIZE=size,status,ATTACHED=attachedTo[*]")
+	RegisterColumns(ovh.VolumeSnapshot{}, "name,id,region,VOLUME=volumeId,SIZE=size,status,CREATED=creationDate")
+	RegisterColumns(ovh.User{}, "id,username,description,status,ROLES=roles[*].name,CREATED=creationDate")
+	RegisterColumns(ovh.Role{}, "name,id,description")
+	RegisterColumns(ovh.Container{}, "name,id,region,TYPE=containerType,archive,OBJECTS=storedObjects,BYTES=storedBytes")
+	RegisterColumns(ovh.S3Credentials{}, "access,secret,TENANT=tenantId,USER=userId")
+	RegisterColumns(ovh.Quota{}, "region,INSTANCES=instance.usedInstances,MAXINSTANCES=instance.maxInstances,CORES=instance.usedCores,MAXCORES=instance.maxCores,RAM=instance.usedRAM,MAXRAM=instance.maxRam,VOLUMES=volume.volumeCount,MAXVOLUMES=volume.maxVolumeCount,GB=volume.usedGigabytes,MAXGB=volume.maxGigabytes")
+	RegisterColumns(ovh.Cost{}, "group,currency,total")
+
+	RegisterColumns(ovh.Domain{}, "domain,offer,NAMESERVERS=nameServerType,TRANSFERLOCK=transferLockStatus,UPDATED=lastUpdate")
+	RegisterColumns(ovh.Vrack{}, "name,description")
+	RegisterColumns(ovh.ContainersService{}, "name,cluster,state,LOADBALANCER=loadBalancer")
+
+	RegisterColumns(ovh.Telephony{}, "BILLINGACCOUNT=billingAccount,description,status,trusted")
+	RegisterColumns(ovh.TelephonyEasyHunting{}, "SERVICE=serviceName,description,FEATURE=featureType,strategy")
+	RegisterColumns(ovh.TelephonyOvhPabxHuntingAgent{}, "ID=agentId,number,status,timeout,LINES=simultaneousLines")
+
+	RegisterColumns(ovh.DBaasQueueApp{}, "id,HUMANID=humanId,name,REGION=regionId,status")
+	RegisterColumns(ovh.DBaasQueueKey{}, "id,name,APP=humanAppId")
+	RegisterColumns(ovh.DBaasQueueRole{}, "name,READ=readAcl[*],WRITE=writeAcl[*],AUTOCREATE=autoCreateACL")
+	RegisterColumns(ovh.DBaasQueueRegion{}, "id,name,url")
+	RegisterColumns(ovh.DBaasQueueTopic{}, "id,partitions,REPLICATION=replicationFactor")
+	RegisterColumns(ovh.DBaasQueueUser{}, "id,name,roles[*]")
+
+	RegisterColumns(ovh.OrderCart{}, "ID=cartId,description,expire,READONLY=readOnly,items[*]")
+	RegisterColumns(ovh.OrderCartItem{}, "ID=itemID,PRODUCT=productId,OFFER=offerId,duration,DOMAIN=settings.domain")
+	RegisterColumns(ovh.OrderCartConfigurationItem{}, "id,label,value")
+	RegisterColumns(ovh.OrderCartConfigurationRequirements{}, "label,type,required,fields[*]")
+}
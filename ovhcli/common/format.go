@@ -4,38 +4,64 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-
 	"os"
+	"strings"
+	"text/template"
 
 	"github.com/ghodss/yaml"
 )
 
 // FormatOutput autmatically formats json based output based on user choice.
 // when selected formatter is "pretty", call prettyFormatter callback.
+// The elements of lists not matching Query, if any, are left out; a single
+// value not matching it is not displayed.
 func FormatOutput(v interface{}, prettyFormatter func([]byte)) {
+	v, match, err := filterOutput(v)
+	Check(err)
+	if !match {
+		return
+	}
+
 	data, e := json.Marshal(v)
 	Check(e)
 
-	switch Format {
+	format, arg := Format, ""
+	if i := strings.Index(Format, "="); i >= 0 {
+		format, arg = Format[:i], Format[i+1:]
+	}
+
+	switch format {
 	case "pretty":
 		prettyFormatter(data)
 	case "json":
 		jsonFormatter(data)
 	case "yaml":
 		yamlFormatter(data)
+	case "template", "go-template":
+		templateFormatter(v, arg)
+	case "jsonpath":
+		jsonpathFormatter(data, arg)
+	case "csv":
+		csvFormatter(v, data)
 	default:
-		fmt.Fprintf(os.Stderr, "Invalid formater %s. Use one of 'pretty', 'json', 'yaml'\n", Format)
+		fmt.Fprintf(os.Stderr, "Invalid formater %s. Use one of 'pretty', 'json', 'yaml', 'csv', 'template=<template>', 'jsonpath=<template>'\n", Format)
 		return
 	}
 }
 
 // FormatOutputDef autmatically formats json based output based on user choice.
-// uses yamlFormatter as pretty formatter.
+// Lists are displayed as tables by the pretty formatter, other values as yaml.
 func FormatOutputDef(v interface{}) {
+	if isList(v) {
+		FormatOutput(v, func(data []byte) { tableFormatter(v, data) })
+		return
+	}
 	FormatOutput(v, yamlFormatter)
 }
 
 // FormatOutputError prints the "message" field of an API return or falls back on FormatOutputDef if the field does not exist
+//
+// Deprecated: the client returns API errors as *ovh.Error, print them with Check
 func FormatOutputError(data []byte) {
 	var errorDesc map[string]interface{}
 	if err := json.Unmarshal(data, &errorDesc); err != nil {
@@ -69,3 +95,44 @@ func yamlFormatter(data []byte) {
 	Check(err)
 	fmt.Print(string(out))
 }
+
+// templateFuncs are the functions available in templates
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"join":  func(sep string, v []string) string { return strings.Join(v, sep) },
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// templateFormatter executes a Go template on v, with the names of the fields
+// of the Go types: {{.ID}}
+func templateFormatter(v interface{}, text string) {
+	t, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	Check(err)
+	var out bytes.Buffer
+	Check(t.Execute(&out, v))
+	printLine(out.String())
+}
+
+// jsonpathFormatter renders data with a JSONPath template, with the names of
+// the fields of the JSON output: {[*].ipAddresses[0].ip}
+func jsonpathFormatter(data []byte, text string) {
+	nodes, err := parseJSONPath(text)
+	Check(err)
+	var value interface{}
+	Check(json.Unmarshal(data, &value))
+	var out bytes.Buffer
+	executeJSONPath(&out, nodes, value)
+	printLine(out.String())
+}
+
+// printLine prints s, ending with a new line
+func printLine(s string) {
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	fmt.Print(s)
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonpathNode is a part of a JSONPath template: some text, the values at a
+// path, or the body of a range over the values at a path
+type jsonpathNode struct {
+	text  string
+	path  *string
+	body  []jsonpathNode
+	isFor bool
+}
+
+// parseJSONPath parses a JSONPath template, kubectl style: text with
+// expressions in braces. An expression is a path such as
+// "{[*].ipAddresses[0].ip}", a quoted string such as {"\n"}, or
+// "{range [*]}...{end}" to repeat a part of the template for each value of a
+// path, relative to which the paths of the part are.
+func parseJSONPath(template string) ([]jsonpathNode, error) {
+	nodes, rest, err := parseJSONPathNodes(template, false)
+	if err == nil && rest != "" {
+		err = fmt.Errorf("unexpected {end}")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Invalid jsonpath %q: %s", template, err)
+	}
+	return nodes, nil
+}
+
+// parseJSONPathNodes parses template until its end or, in a range, until {end}.
+// It returns the remaining template, after {end}.
+func parseJSONPathNodes(template string, inRange bool) ([]jsonpathNode, string, error) {
+	nodes := []jsonpathNode{}
+	for template != "" {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			nodes = append(nodes, jsonpathNode{text: template})
+			break
+		}
+		if start > 0 {
+			nodes = append(nodes, jsonpathNode{text: template[:start]})
+		}
+		end := strings.Index(template[start:], "}")
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated expression %s", template[start:])
+		}
+		expr := strings.TrimSpace(template[start+1 : start+end])
+		template = template[start+end+1:]
+
+		switch {
+		case expr == "end":
+			if !inRange {
+				return nil, "", fmt.Errorf("unexpected {end}")
+			}
+			return nodes, template, nil
+		case strings.HasPrefix(expr, "range "):
+			path := jsonpathPath(strings.TrimPrefix(expr, "range "))
+			body, rest, err := parseJSONPathNodes(template, true)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, jsonpathNode{path: &path, body: body, isFor: true})
+			template = rest
+		case strings.HasPrefix(expr, `"`):
+			text, err := strconv.Unquote(expr)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid string %s", expr)
+			}
+			nodes = append(nodes, jsonpathNode{text: text})
+		case strings.HasPrefix(expr, "'") && strings.HasSuffix(expr, "'") && len(expr) > 1:
+			nodes = append(nodes, jsonpathNode{text: expr[1 : len(expr)-1]})
+		default:
+			path := jsonpathPath(expr)
+			nodes = append(nodes, jsonpathNode{path: &path})
+		}
+	}
+	if inRange {
+		return nil, "", fmt.Errorf("missing {end}")
+	}
+	return nodes, "", nil
+}
+
+// jsonpathPath removes the root of a path, $ or @, implicit for lookup
+func jsonpathPath(path string) string {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+	path = strings.TrimPrefix(path, "@")
+	return strings.TrimPrefix(path, ".")
+}
+
+// executeJSONPath renders nodes for a decoded JSON value. The values found at
+// a path are separated by spaces.
+func executeJSONPath(out *bytes.Buffer, nodes []jsonpathNode, value interface{}) {
+	for _, node := range nodes {
+		switch {
+		case node.path == nil:
+			out.WriteString(node.text)
+		case node.isFor:
+			for _, v := range lookup(value, *node.path) {
+				executeJSONPath(out, node.body, v)
+			}
+		default:
+			values := []string{}
+			for _, v := range lookup(value, *node.path) {
+				values = append(values, formatValue(v))
+			}
+			out.WriteString(strings.Join(values, " "))
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// predicate tells if a decoded JSON value matches a query
+type predicate func(value interface{}) bool
+
+// filterOutput keeps the elements of the list v matching Query, or tells if
+// the single value v matches it. The elements kept are the original ones, of
+// the same type, so that every format applies to them.
+func filterOutput(v interface{}) (interface{}, bool, error) {
+	if Query == "" {
+		return v, true, nil
+	}
+	match, err := parseQuery(Query)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !isList(v) {
+		var value interface{}
+		if err = json.Unmarshal(data, &value); err != nil {
+			return nil, false, err
+		}
+		return v, match(value), nil
+	}
+
+	var values []interface{}
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil, false, err
+	}
+	list := reflect.ValueOf(v)
+	res := reflect.MakeSlice(reflect.SliceOf(list.Type().Elem()), 0, len(values))
+	for i, value := range values {
+		if match(value) {
+			res = reflect.Append(res, list.Index(i))
+		}
+	}
+	return res.Interface(), true, nil
+}
+
+// parseQuery compiles a query: comparisons of JSON paths with values, such as
+// "status==ACTIVE", combined with &&, || and !, and grouped with parentheses.
+//
+// The operators are == and != for equality, =~ and !~ for regular expressions,
+// and <, <=, > and >=, comparing numbers as numbers. A path alone is true when
+// one of its values is neither null, false, 0, nor empty. A path matching
+// several values, such as "ipAddresses[*].ip", is true when one of them is.
+// Values containing spaces or operators are quoted: name=='my server'.
+func parseQuery(query string) (predicate, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid query %q: %s", query, err)
+	}
+	p := &queryParser{tokens: tokens}
+	match, err := p.or()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Invalid query %q: %s", query, err)
+	}
+	return match, nil
+}
+
+// queryOperators are the operators of queries, longest first
+var queryOperators = []string{"==", "!=", "=~", "!~", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")"}
+
+type queryToken struct {
+	text string
+	// operator is false for paths and values
+	operator bool
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	tokens := []queryToken{}
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+			continue
+		case c == '"' || c == '\'':
+			j := strings.IndexByte(query[i+1:], c)
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated string %s", query[i:])
+			}
+			tokens = append(tokens, queryToken{text: query[i+1 : i+1+j]})
+			i += j + 2
+			continue
+		}
+
+		operator := ""
+		for _, op := range queryOperators {
+			if strings.HasPrefix(query[i:], op) {
+				operator = op
+				break
+			}
+		}
+		if operator != "" {
+			tokens = append(tokens, queryToken{text: operator, operator: true})
+			i += len(operator)
+			continue
+		}
+		if strings.ContainsRune("=&|", rune(c)) {
+			return nil, fmt.Errorf("unexpected %c", c)
+		}
+
+		j := i
+		for j < len(query) && !unicode.IsSpace(rune(query[j])) && !strings.ContainsRune("=!<>&|()", rune(query[j])) {
+			j++
+		}
+		tokens = append(tokens, queryToken{text: query[i:j]})
+		i = j
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+// accept consumes the next token if it is the operator op
+func (p *queryParser) accept(op string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].operator && p.tokens[p.pos].text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// operand consumes the next token, a path or a value
+func (p *queryParser) operand() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end")
+	}
+	token := p.tokens[p.pos]
+	if token.operator {
+		return "", fmt.Errorf("unexpected %s", token.text)
+	}
+	p.pos++
+	return token.text, nil
+}
+
+func (p *queryParser) or() (predicate, error) {
+	left, err := p.and()
+	for err == nil && p.accept("||") {
+		var right predicate
+		if right, err = p.and(); err == nil {
+			l := left
+			left = func(v interface{}) bool { return l(v) || right(v) }
+		}
+	}
+	return left, err
+}
+
+func (p *queryParser) and() (predicate, error) {
+	left, err := p.unary()
+	for err == nil && p.accept("&&") {
+		var right predicate
+		if right, err = p.unary(); err == nil {
+			l := left
+			left = func(v interface{}) bool { return l(v) && right(v) }
+		}
+	}
+	return left, err
+}
+
+func (p *queryParser) unary() (predicate, error) {
+	if p.accept("!") {
+		match, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(v interface{}) bool { return !match(v) }, nil
+	}
+	if p.accept("(") {
+		match, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing )")
+		}
+		return match, nil
+	}
+	return p.comparison()
+}
+
+func (p *queryParser) comparison() (predicate, error) {
+	path, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	op := ""
+	for _, o := range []string{"==", "!=", "=~", "!~", "<=", ">=", "<", ">"} {
+		if p.accept(o) {
+			op = o
+			break
+		}
+	}
+	if op == "" {
+		return func(v interface{}) bool { return anyValue(v, path, isTruthy) }, nil
+	}
+
+	operand, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	if op == "=~" || op == "!~" {
+		re, err := regexp.Compile(operand)
+		if err != nil {
+			return nil, err
+		}
+		matches := func(v interface{}) bool {
+			return anyValue(v, path, func(x interface{}) bool { return re.MatchString(formatValue(x)) })
+		}
+		if op == "!~" {
+			return func(v interface{}) bool { return !matches(v) }, nil
+		}
+		return matches, nil
+	}
+
+	var ok func(int) bool
+	switch op {
+	case "==", "!=":
+		ok = func(c int) bool { return c == 0 }
+	case "<":
+		ok = func(c int) bool { return c < 0 }
+	case "<=":
+		ok = func(c int) bool { return c <= 0 }
+	case ">":
+		ok = func(c int) bool { return c > 0 }
+	default:
+		ok = func(c int) bool { return c >= 0 }
+	}
+	matches := func(v interface{}) bool {
+		return anyValue(v, path, func(x interface{}) bool { return ok(compare(x, operand)) })
+	}
+	if op == "!=" {
+		return func(v interface{}) bool { return !matches(v) }, nil
+	}
+	return matches, nil
+}
+
+// anyValue tells if one of the values found at path in v satisfies f
+func anyValue(v interface{}, path string, f func(interface{}) bool) bool {
+	for _, value := range lookup(v, path) {
+		if f(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compare compares a JSON value to an operand, as numbers when both are
+func compare(value interface{}, operand string) int {
+	s := formatValue(value)
+	x, errX := strconv.ParseFloat(s, 64)
+	y, errY := strconv.ParseFloat(operand, 64)
+	switch {
+	case errX == nil && errY == nil && x < y:
+		return -1
+	case errX == nil && errY == nil && x > y:
+		return 1
+	case errX == nil && errY == nil:
+		return 0
+	}
+	return strings.Compare(s, operand)
+}
+
+// isTruthy tells if a JSON value is neither null, false, 0, nor empty
+func isTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
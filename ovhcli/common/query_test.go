@@ -0,0 +1,102 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestQuery(t *testing.T) {
+	defer func() { Query = "" }()
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"web-2", "web-10"}},
+		{"status==ACTIVE", []string{"web-2"}},
+		{"status!=ACTIVE", []string{"web-10"}},
+		{"region=~^GRA || flavor.name=='b2-7'", []string{"web-2", "web-10"}},
+		{"ipAddresses[*].ip=~'^2001:' && !(status==BUILD)", []string{"web-2"}},
+		{"ipAddresses", []string{"web-2"}},
+		{"!ipAddresses", []string{"web-10"}},
+		{"name>web-3", []string{}},
+	}
+	for _, test := range tests {
+		Query = test.query
+		v, match, err := filterOutput(instances)
+		if err != nil || !match {
+			t.Fatalf("%s: unexpected error %v", test.query, err)
+		}
+		names := []string{}
+		for _, instance := range v.([]ovh.Instance) {
+			names = append(names, instance.Name)
+		}
+		if len(names) != len(test.expected) {
+			t.Fatalf("%s: expected %v, got %v", test.query, test.expected, names)
+		}
+		for i := range names {
+			if names[i] != test.expected[i] {
+				t.Fatalf("%s: expected %v, got %v", test.query, test.expected, names)
+			}
+		}
+	}
+
+	// numbers are compared as numbers
+	Query = "partitions>=10"
+	v, _, err := filterOutput([]ovh.DBaasQueueTopic{{ID: "t10", Partitions: 10}, {ID: "t9", Partitions: 9}})
+	if err != nil || len(v.([]ovh.DBaasQueueTopic)) != 1 {
+		t.Fatalf("unexpected topics %v, %v", v, err)
+	}
+
+	// single values are displayed or not
+	Query = "status==BUILD"
+	if _, match, _ := filterOutput(instances[0]); match {
+		t.Fatal("expected the instance not to match")
+	}
+
+	for _, query := range []string{"status=ACTIVE", "status==", "(status==ACTIVE", "name=='web", "status==ACTIVE)", "name=~["} {
+		Query = query
+		if _, _, err := filterOutput(instances); err == nil {
+			t.Fatalf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	data, err := json.Marshal(instances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"{[*].ipAddresses[0].ip}": "192.0.2.2",
+		"{$[*].name}":             "web-2 web-10",
+		`{range [*]}{.name}{"\t"}{.flavor.name}{"\n"}{end}`: "web-2\ts1-2\nweb-10\tb2-7\n",
+		"names: {[-1].name}": "names: web-10",
+		"{[0].ipAddresses}":  `[{"ip":"192.0.2.2"},{"ip":"2001:db8::2"}]`,
+	}
+	for template, expected := range tests {
+		nodes, err := parseJSONPath(template)
+		if err != nil {
+			t.Fatalf("%s: %s", template, err)
+		}
+		out := &bytes.Buffer{}
+		executeJSONPath(out, nodes, value)
+		if out.String() != expected {
+			t.Fatalf("%s: expected %q, got %q", template, expected, out.String())
+		}
+	}
+
+	for _, template := range []string{"{[*].name", "{range [*]}{.name}", "{.name}{end}", `{"\q"}`} {
+		if _, err := parseJSONPath(template); err == nil {
+			t.Fatalf("%s: expected an error", template)
+		}
+	}
+}
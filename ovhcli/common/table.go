@@ -0,0 +1,345 @@
+package common
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// minColumnWidth is the width under which columns are not truncated to fit
+// the terminal
+const minColumnWidth = 6
+
+// Column is a column of a table: a header and the JSON path of its values,
+// such as "flavor.name" or "ipAddresses[*].ip"
+type Column struct {
+	Header string
+	Path   string
+}
+
+// defaultColumns are the columns registered per listed type
+var defaultColumns = map[reflect.Type][]Column{}
+
+// RegisterColumns sets the default columns of the tables listing values of
+// the type of v. See ParseColumns for the syntax of spec.
+func RegisterColumns(v interface{}, spec string) {
+	defaultColumns[reflect.TypeOf(v)] = ParseColumns(spec)
+}
+
+// indexPattern matches the array steps of JSON paths
+var indexPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// ParseColumns parses a comma separated list of JSON paths, each one
+// optionally prefixed with a header: "name,IPS=ipAddresses[*].ip". The header
+// of a path without one is the path in upper case, without array indexes.
+func ParseColumns(spec string) []Column {
+	columns := []Column{}
+	for _, c := range strings.Split(spec, ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		header, path := "", c
+		if i := strings.Index(c, "="); i >= 0 {
+			header, path = c[:i], c[i+1:]
+		}
+		if header == "" {
+			header = strings.ToUpper(indexPattern.ReplaceAllString(path, ""))
+		}
+		columns = append(columns, Column{Header: header, Path: path})
+	}
+	return columns
+}
+
+// isList tells if v is a slice or an array, displayed as a table
+func isList(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	kind := reflect.TypeOf(v).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// columnsOf returns the columns to display for a list of elements of type t:
+// the ones given with --columns, the registered ones, or the scalar fields of t
+func columnsOf(t reflect.Type) []Column {
+	if Columns != "" {
+		return ParseColumns(Columns)
+	}
+	if columns, ok := defaultColumns[t]; ok {
+		return columns
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []Column{{Header: "VALUE"}}
+	}
+
+	columns := []Column{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.PkgPath != "" || name == "-" || !isScalar(field.Type) {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, Column{Header: strings.ToUpper(name), Path: name})
+	}
+	return columns
+}
+
+// isScalar tells if values of type t fit in a table cell
+func isScalar(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	// dates are rendered as strings
+	return t.PkgPath() == "time" && t.Name() == "Time"
+}
+
+// tableFormatter displays data, the JSON encoding of the list v, as a table
+func tableFormatter(v interface{}, data []byte) {
+	var rows []interface{}
+	Check(json.Unmarshal(data, &rows))
+
+	renderTable(os.Stdout, rows, columnsOf(reflect.TypeOf(v).Elem()), terminalWidth())
+}
+
+// renderTable writes rows as a table of columns, fitting in width unless 0
+func renderTable(w io.Writer, rows []interface{}, columns []Column, width int) {
+	cells := tableCells(rows, columns)
+
+	widths := make([]int, len(columns))
+	for _, row := range cells {
+		for j, cell := range row {
+			if n := utf8.RuneCountInString(cell); n > widths[j] {
+				widths[j] = n
+			}
+		}
+	}
+	fitWidths(widths, width)
+
+	for _, row := range cells {
+		line := make([]string, len(row))
+		for j, cell := range row {
+			cell = truncate(cell, widths[j])
+			if j < len(row)-1 {
+				cell += strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+			}
+			line[j] = cell
+		}
+		fmt.Fprintln(w, strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+// csvFormatter displays data, the JSON encoding of v, as CSV records of the
+// columns of tables. A value which is not a list is a single record.
+func csvFormatter(v interface{}, data []byte) {
+	if v == nil {
+		return
+	}
+	t := reflect.TypeOf(v)
+	if isList(v) {
+		t = t.Elem()
+	} else {
+		data = append(append([]byte("["), data...), ']')
+	}
+	var rows []interface{}
+	Check(json.Unmarshal(data, &rows))
+
+	Check(renderCSV(os.Stdout, rows, columnsOf(t)))
+}
+
+// renderCSV writes rows as CSV records of columns
+func renderCSV(w io.Writer, rows []interface{}, columns []Column) error {
+	return csv.NewWriter(w).WriteAll(tableCells(rows, columns))
+}
+
+// tableCells returns the cells of the columns of rows, sorted by SortBy, after
+// a row of headers unless NoHeaders
+func tableCells(rows []interface{}, columns []Column) [][]string {
+	cells := make([][]string, len(rows))
+	for i, row := range rows {
+		cells[i] = make([]string, len(columns))
+		for j, column := range columns {
+			cells[i][j] = cellValue(row, column.Path)
+		}
+	}
+
+	if SortBy != "" {
+		path := SortBy
+		for _, column := range columns {
+			if strings.EqualFold(column.Header, SortBy) {
+				path = column.Path
+			}
+		}
+		keys := make([]string, len(rows))
+		for i, row := range rows {
+			keys[i] = cellValue(row, path)
+		}
+		order := make([]int, len(rows))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool { return less(keys[order[a]], keys[order[b]]) })
+		sorted := make([][]string, len(cells))
+		for i, o := range order {
+			sorted[i] = cells[o]
+		}
+		cells = sorted
+	}
+
+	if !NoHeaders {
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = column.Header
+		}
+		cells = append([][]string{headers}, cells...)
+	}
+	return cells
+}
+
+// fitWidths shrinks the widest columns until the table fits in width
+func fitWidths(widths []int, width int) {
+	if width <= 0 {
+		return
+	}
+	for {
+		total, widest := 2*(len(widths)-1), 0
+		for i, w := range widths {
+			total += w
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if total <= width || widths[widest] <= minColumnWidth {
+			return
+		}
+		widths[widest]--
+	}
+}
+
+// truncate shortens s to width runes, marking the cut with an ellipsis
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + "…"
+}
+
+// less compares two cells, as numbers when both are
+func less(a, b string) bool {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return a < b
+}
+
+// cellValue renders the values found at path in row, comma separated
+func cellValue(row interface{}, path string) string {
+	values := []string{}
+	for _, value := range lookup(row, path) {
+		values = append(values, formatValue(value))
+	}
+	return strings.Join(values, ",")
+}
+
+// formatValue renders a JSON value
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// lookup returns the values found at path in a decoded JSON value. Path is
+// made of keys separated by dots, and of [index] or [*] to select one or all
+// elements of arrays: "ipAddresses[*].ip". An empty path is the value itself.
+func lookup(value interface{}, path string) []interface{} {
+	values := []interface{}{value}
+	for _, step := range splitPath(path) {
+		next := []interface{}{}
+		for _, v := range values {
+			switch {
+			case step == "[*]":
+				if a, ok := v.([]interface{}); ok {
+					next = append(next, a...)
+				}
+			case strings.HasPrefix(step, "["):
+				a, ok := v.([]interface{})
+				i, err := strconv.Atoi(strings.Trim(step, "[]"))
+				if i < 0 {
+					i += len(a)
+				}
+				if ok && err == nil && i >= 0 && i < len(a) {
+					next = append(next, a[i])
+				}
+			default:
+				if m, ok := v.(map[string]interface{}); ok {
+					if field, ok := m[step]; ok {
+						next = append(next, field)
+					}
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// splitPath splits a JSON path into keys and [index] steps
+func splitPath(path string) []string {
+	steps := []string{}
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			i := strings.Index(part, "[")
+			switch {
+			case i < 0:
+				steps = append(steps, part)
+				part = ""
+			case i > 0:
+				steps = append(steps, part[:i])
+				part = part[i:]
+			default:
+				j := strings.Index(part, "]")
+				if j < 0 {
+					j = len(part) - 1
+				}
+				steps = append(steps, part[:j+1])
+				part = part[j+1:]
+			}
+		}
+	}
+	return steps
+}
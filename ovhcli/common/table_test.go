@@ -0,0 +1,118 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// render renders v as a table in width
+func render(t *testing.T, v interface{}, width int) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows []interface{}
+	if err = json.Unmarshal(data, &rows); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &bytes.Buffer{}
+	renderTable(out, rows, columnsOf(reflect.TypeOf(v).Elem()), width)
+	return out.String()
+}
+
+var instances = []ovh.Instance{
+	{Name: "web-2", ID: "i-2", Status: "ACTIVE", Region: "GRA3", Flavor: &ovh.Flavor{Name: "s1-2"}, IPAddresses: []ovh.IP{{IP: "192.0.2.2"}, {IP: "2001:db8::2"}}},
+	{Name: "web-10", ID: "i-10", Status: "BUILD", Region: "BHS3", Flavor: &ovh.Flavor{Name: "b2-7"}},
+}
+
+func TestTableDefaultColumns(t *testing.T) {
+	expected := `NAME    ID    STATUS  REGION  FLAVOR  IPS
+web-2   i-2   ACTIVE  GRA3    s1-2    192.0.2.2,2001:db8::2
+web-10  i-10  BUILD   BHS3    b2-7
+`
+	if out := render(t, instances, 0); out != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+}
+
+func TestTableOptions(t *testing.T) {
+	Columns, NoHeaders, SortBy = "name,flavor.name,ADDRESS=ipAddresses[0].ip", true, "name"
+	defer func() { Columns, NoHeaders, SortBy = "", false, "" }()
+
+	expected := `web-10  b2-7
+web-2   s1-2  192.0.2.2
+`
+	if out := render(t, instances, 0); out != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+
+	// numbers are sorted as numbers
+	SortBy = "ID"
+	Columns = "id"
+	if out := render(t, []ovh.DBaasQueueTopic{{ID: "t10", Partitions: 10}, {ID: "t9", Partitions: 9}}, 0); out != "t10\nt9\n" {
+		t.Fatalf("unexpected sort by id: %q", out)
+	}
+	SortBy = "partitions"
+	if out := render(t, []ovh.DBaasQueueTopic{{ID: "t10", Partitions: 10}, {ID: "t9", Partitions: 9}}, 0); out != "t9\nt10\n" {
+		t.Fatalf("unexpected sort by partitions: %q", out)
+	}
+}
+
+func TestTableTruncation(t *testing.T) {
+	out := render(t, instances, 48)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if n := len([]rune(line)); n > 48 {
+			t.Fatalf("line of %d runes: %q", n, line)
+		}
+	}
+	if !strings.Contains(out, "…") {
+		t.Fatalf("expected truncated cells, got\n%s", out)
+	}
+}
+
+func TestTableReflectedColumns(t *testing.T) {
+	type thing struct {
+		Name    string            `json:"name"`
+		Size    int               `json:"size,omitempty"`
+		Labels  map[string]string `json:"labels"`
+		Ignored string            `json:"-"`
+	}
+	expected := "NAME  SIZE\nbox   3\n"
+	if out := render(t, []thing{{Name: "box", Size: 3}}, 0); out != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+
+	if out := render(t, []string{"GRA3", "BHS3"}, 0); out != "VALUE\nGRA3\nBHS3\n" {
+		t.Fatalf("unexpected table of strings %q", out)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	data, err := json.Marshal(instances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows []interface{}
+	if err = json.Unmarshal(data, &rows); err != nil {
+		t.Fatal(err)
+	}
+
+	// cells are neither truncated nor padded, and quoted when needed
+	expected := `NAME,ID,STATUS,REGION,FLAVOR,IPS
+web-2,i-2,ACTIVE,GRA3,s1-2,"192.0.2.2,2001:db8::2"
+web-10,i-10,BUILD,BHS3,b2-7,
+`
+	out := &bytes.Buffer{}
+	if err = renderCSV(out, rows, columnsOf(reflect.TypeOf(instances).Elem())); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, out)
+	}
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"os"
+	"strconv"
+)
+
+// terminalWidth returns the width of the terminal displaying the output, from
+// the COLUMNS environment variable or the terminal itself. It returns 0 when
+// the output is not a terminal, e.g. piped to another command.
+func terminalWidth() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return ttyWidth(os.Stdout)
+}
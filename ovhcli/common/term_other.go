@@ -0,0 +1,11 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+
+package common
+
+import "os"
+
+// ttyWidth returns 0: the terminal width is only read on unix systems, set
+// the COLUMNS environment variable elsewhere
+func ttyWidth(f *os.File) int {
+	return 0
+}
@@ -1,9 +1,42 @@
 package common
 
+import "time"
+
 var (
-	// Format to use for output. One of 'json', 'yaml', 'pretty'
+	// Format to use for output. One of 'json', 'yaml', 'pretty',
+	// 'template=<template>', 'jsonpath=<template>'
 	Format string
 
+	// Query filters the output, see parseQuery
+	Query string
+
 	// Verbose ...
 	Verbose bool
+
+	// Columns overrides the default columns of the pretty format of lists
+	Columns string
+
+	// NoHeaders hides the headers of the pretty format of lists
+	NoHeaders bool
+
+	// SortBy is the column or JSON path sorting the pretty format of lists
+	SortBy string
+
+	// Profile is the name of the configuration profile to use. Empty for the default one
+	Profile string
+
+	// Concurrency is the maximum number of concurrent API calls of listings with details
+	Concurrency int
+
+	// Retries is the number of retries of failed API calls
+	Retries int
+
+	// RetryMaxWait caps the wait between two attempts of an API call
+	RetryMaxWait time.Duration
+
+	// Record is the cassette file saving the API calls, if any
+	Record string
+
+	// Replay is the cassette file serving the API calls, if any
+	Replay string
 )
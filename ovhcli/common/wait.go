@@ -0,0 +1,39 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Wait makes asynchronous commands wait until their operation is done
+	Wait bool
+
+	// WaitTimeout is how long asynchronous commands wait with --wait
+	WaitTimeout time.Duration
+)
+
+// AddWaitFlags registers the --wait and --timeout flags of an asynchronous command
+func AddWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&Wait, "wait", false, "wait until the operation is done, showing its progress on stderr")
+	cmd.Flags().DurationVar(&WaitTimeout, "timeout", 10*time.Minute, "maximum time to wait with --wait")
+}
+
+// WaitOptions returns the options of a waiter for --timeout, printing the
+// progress of what on stderr when its status changes
+func WaitOptions(what string) []ovh.WaitOption {
+	last := ""
+	return []ovh.WaitOption{
+		ovh.WithWaitTimeout(WaitTimeout),
+		ovh.WithWaitProgress(func(status string, elapsed time.Duration) {
+			if status != last {
+				fmt.Fprintf(os.Stderr, "%s: %s (%s)\n", what, status, elapsed.Round(time.Second))
+				last = status
+			}
+		}),
+	}
+}
@@ -32,7 +32,13 @@ func writeConsumerKeyFile(filename string, consumerKey string) (err error) {
 		return errors.New("Cannot load file " + filename)
 	}
 
-	if defaultSection, err := cfg.GetSection("default"); err == nil {
+	if common.Profile != "" {
+		// the profile section holds its own keys
+		endpoint = ovh.ProfileSection(common.Profile)
+		if _, err = cfg.GetSection(endpoint); err != nil {
+			return errors.New("Cannot read section " + endpoint)
+		}
+	} else if defaultSection, err := cfg.GetSection("default"); err == nil {
 		if endpointKey, err = defaultSection.GetKey("endpoint"); err != nil {
 			return errors.New("Cannot read endpoint from configuration")
 		}
@@ -78,7 +84,7 @@ var Cmd = &cobra.Command{
 	Long:  `Domain commands: ovhcli connect`,
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		ckReq := client.OVHClient.NewCkRequest()
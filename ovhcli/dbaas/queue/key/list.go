@@ -1,7 +1,6 @@
 package key
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ var cmdList = &cobra.Command{
 	Short: "List all keys on a service: ovhcli dbaas queue key (--name=AppName | <--id=appID>)",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		if name != "" {
@@ -1,7 +1,6 @@
 package metrics
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -15,7 +14,7 @@ var cmdAccount = &cobra.Command{
 	Short: "Get metrics account: ovhcli dbaas queue metrics account (--name=AppName | <--id=appID>)",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		if name != "" {
@@ -1,7 +1,6 @@
 package role
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ var cmdInfo = &cobra.Command{
 	Short: "Get Role Info: ovhcli dbaas queue role info (--name=AppName | <--id=appID>) --role-id=roleid",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		if name != "" {
@@ -1,7 +1,6 @@
 package service
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -12,7 +11,7 @@ var cmdInfo = &cobra.Command{
 	Short: "Get Application Info: ovhcli dbaas queue service info (--name=AppName | <--id=appID>)",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		if name != "" {
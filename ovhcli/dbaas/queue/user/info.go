@@ -3,7 +3,6 @@ package user
 import (
 	"github.com/spf13/cobra"
 
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 )
 
@@ -12,7 +11,7 @@ var cmdInfo = &cobra.Command{
 	Short: "Get User Info: ovhcli dbaas queue user info (--name=AppName | <--id=appID>) --user=username",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		if name == "" {
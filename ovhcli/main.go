@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/caas"
 	"github.com/admdwrf/ovhcli/ovhcli/cloud"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
@@ -25,8 +26,18 @@ var rootCmd = &cobra.Command{
 }
 
 func main() {
-	rootCmd.PersistentFlags().StringVarP(&common.Format, "format", "f", "pretty", "choose format output. One of 'json', 'yaml' and 'pretty'")
+	rootCmd.PersistentFlags().StringVarP(&common.Format, "format", "f", "pretty", "choose format output. One of 'json', 'yaml', 'pretty', 'csv', 'template=<go template>' and 'jsonpath=<template>'")
+	rootCmd.PersistentFlags().StringVarP(&common.Query, "query", "", "", "filter the output with an expression such as \"status==ACTIVE && region=~^GRA\"")
+	rootCmd.PersistentFlags().StringVarP(&common.Columns, "columns", "", "", "comma separated columns of the pretty format of lists, as JSON paths optionally prefixed with a header: name,IPS=ipAddresses[*].ip")
+	rootCmd.PersistentFlags().BoolVarP(&common.NoHeaders, "no-headers", "", false, "hide the headers of the pretty format of lists")
+	rootCmd.PersistentFlags().StringVarP(&common.SortBy, "sort-by", "", "", "column or JSON path sorting the pretty format of lists")
 	rootCmd.PersistentFlags().BoolVarP(&common.Verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().IntVarP(&common.Concurrency, "concurrency", "", ovh.DefaultConcurrency, "maximum number of concurrent API calls of listings with details")
+	rootCmd.PersistentFlags().IntVarP(&common.Retries, "retries", "", ovh.DefaultRetries, "number of retries of API calls failing on network errors, rate limits or server errors")
+	rootCmd.PersistentFlags().DurationVarP(&common.RetryMaxWait, "retry-max-wait", "", ovh.DefaultRetryMaxWait, "maximum wait between two attempts of an API call")
+	rootCmd.PersistentFlags().StringVarP(&common.Record, "record", "", "", "save the API calls to a cassette file, with secrets redacted")
+	rootCmd.PersistentFlags().StringVarP(&common.Replay, "replay", "", "", "serve the API calls from a cassette file saved with --record")
+	rootCmd.PersistentFlags().StringVarP(&common.Profile, "profile", "p", os.Getenv("OVH_PROFILE"), "configuration profile to use, read from a [profile <name>] section of ovh.conf")
 
 	addCommands()
 	if err := rootCmd.Execute(); err != nil {
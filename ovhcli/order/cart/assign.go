@@ -1,7 +1,6 @@
 package cart
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -16,7 +15,7 @@ var cmdCartAssign = &cobra.Command{
 		}
 		cartID := args[0]
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		err = client.OrderAssignCart(cartID)
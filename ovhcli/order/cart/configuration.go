@@ -1,6 +1,7 @@
 package cart
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -40,7 +41,7 @@ var CmdCartItemConfigurationsList = &cobra.Command{
 		if len(args) != 1 {
 			common.WrongUsage(cmd)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 
 		common.Check(err)
 		itemID := args[0]
@@ -74,7 +75,7 @@ var CmdCartItemConfigurationInfo = &cobra.Command{
 		c, err := strconv.Atoi(configID)
 		common.Check(err)
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 		config, err := client.OrderCartConfigurationInfo(cartID, i, c)
 		common.Check(err)
@@ -97,7 +98,7 @@ var CmdCartItemConfigurationAdd = &cobra.Command{
 			fmt.Println(err)
 			os.Exit(2)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		item, err := client.OrderCartAddConfiguration(cartID, i, label, value)
@@ -123,7 +124,7 @@ var CmdCartItemConfigurationRemove = &cobra.Command{
 		c, err := strconv.Atoi(configID)
 		common.Check(err)
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		config, err := client.OrderCartDeleteConfiguration(cartID, i, c)
@@ -134,31 +135,12 @@ var CmdCartItemConfigurationRemove = &cobra.Command{
 }
 
 func getDetailledConfigurationsList(client *ovh.Client, itemID int, configs []ovh.OrderCartConfigurationItem) []ovh.OrderCartConfigurationItem {
+	configsComplete, err := ovh.FetchDetails(context.Background(), client, configs, func(ctx context.Context, config ovh.OrderCartConfigurationItem) (*ovh.OrderCartConfigurationItem, error) {
+		return client.OrderCartConfigurationInfoCtx(ctx, cartID, itemID, config.ID)
+	})
+	common.Check(err)
 
-	resChan, errChan := make(chan ovh.OrderCartConfigurationItem), make(chan error)
-	for _, config := range configs {
-		go func(config ovh.OrderCartConfigurationItem) {
-			i, err := client.OrderCartConfigurationInfo(cartID, itemID, config.ID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			resChan <- *i
-		}(config)
-	}
-
-	itemsComplete := []ovh.OrderCartConfigurationItem{}
-
-	for i := 0; i < len(configs); i++ {
-		select {
-		case item := <-resChan:
-			itemsComplete = append(itemsComplete, item)
-		case err := <-errChan:
-			common.Check(err)
-		}
-	}
-
-	return itemsComplete
+	return configsComplete
 }
 
 //CmdCartItemRequiredConfigurations list all configurations for an item
@@ -169,7 +151,7 @@ var CmdCartItemRequiredConfigurations = &cobra.Command{
 		if len(args) != 1 {
 			common.WrongUsage(cmd)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 
 		common.Check(err)
 		itemID := args[0]
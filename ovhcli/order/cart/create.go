@@ -23,7 +23,7 @@ var cmdCartCreate = &cobra.Command{
 	Use:   "create",
 	Short: "Create order cart : ovhcli order cart create",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		var expireTime *time.Time
@@ -27,7 +27,7 @@ var cmdListProductsDomain = &cobra.Command{
 		}
 		domain := args[0]
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		c, err := client.OrderGetProductsDomain(cartID, domain)
@@ -45,7 +45,7 @@ var cmdAddProductDomain = &cobra.Command{
 		}
 		domain := args[0]
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		c, err := client.OrderAddProductDomain(cartID, ovh.OrderPostDomainReq{
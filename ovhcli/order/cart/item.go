@@ -1,6 +1,7 @@
 package cart
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -36,7 +37,7 @@ var CmdCartListItems = &cobra.Command{
 	Use:   "listItems",
 	Short: "List all items of a cart: ovhcli order cart list",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		items, err := client.OrderCartItemList(cartID)
@@ -64,7 +65,7 @@ var CmdCartInfoItem = &cobra.Command{
 			fmt.Println(err)
 			os.Exit(2)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 		item, err := client.OrderCartItemInfo(cartID, i)
 		common.Check(err)
@@ -87,7 +88,7 @@ var CmdCartUpdateItem = &cobra.Command{
 			fmt.Println(err)
 			os.Exit(2)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		item, err := client.OrderUpdateCartItem(cartID, i, duration, quantity)
@@ -111,7 +112,7 @@ var CmdCartDeleteItem = &cobra.Command{
 			fmt.Println(err)
 			os.Exit(2)
 		}
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		item, err := client.OrderDeleteCartItem(cartID, i)
@@ -122,29 +123,10 @@ var CmdCartDeleteItem = &cobra.Command{
 }
 
 func getDetailledItemList(client *ovh.Client, items []ovh.OrderCartItem) []ovh.OrderCartItem {
-
-	itemsChan, errChan := make(chan ovh.OrderCartItem), make(chan error)
-	for _, item := range items {
-		go func(item ovh.OrderCartItem) {
-			i, err := client.OrderCartItemInfo(cartID, item.ItemID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			itemsChan <- *i
-		}(item)
-	}
-
-	itemsComplete := []ovh.OrderCartItem{}
-
-	for i := 0; i < len(items); i++ {
-		select {
-		case item := <-itemsChan:
-			itemsComplete = append(itemsComplete, item)
-		case err := <-errChan:
-			common.Check(err)
-		}
-	}
+	itemsComplete, err := ovh.FetchDetails(context.Background(), client, items, func(ctx context.Context, item ovh.OrderCartItem) (*ovh.OrderCartItem, error) {
+		return client.OrderCartItemInfoCtx(ctx, cartID, item.ItemID)
+	})
+	common.Check(err)
 
 	return itemsComplete
 }
@@ -1,6 +1,8 @@
 package cart
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
@@ -18,7 +20,7 @@ var cmdCartList = &cobra.Command{
 	Short: "List all carts: ovhcli cart list",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		carts, err := client.OrderCartList()
@@ -33,29 +35,10 @@ var cmdCartList = &cobra.Command{
 }
 
 func getDetailledCartsList(client *ovh.Client, carts []ovh.OrderCart) []ovh.OrderCart {
-
-	cartsChan, errChan := make(chan ovh.OrderCart), make(chan error)
-	for _, cart := range carts {
-		go func(cart ovh.OrderCart) {
-			c, err := client.OrderCartInfo(cart.CartID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			cartsChan <- *c
-		}(cart)
-	}
-
-	cartsComplete := []ovh.OrderCart{}
-
-	for i := 0; i < len(carts); i++ {
-		select {
-		case cart := <-cartsChan:
-			cartsComplete = append(cartsComplete, cart)
-		case err := <-errChan:
-			common.Check(err)
-		}
-	}
+	cartsComplete, err := ovh.FetchDetails(context.Background(), client, carts, func(ctx context.Context, cart ovh.OrderCart) (*ovh.OrderCart, error) {
+		return client.OrderCartInfoCtx(ctx, cart.CartID)
+	})
+	common.Check(err)
 
 	return cartsComplete
 }
@@ -1,7 +1,6 @@
 package cart
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -15,7 +14,7 @@ var cmdCartSummary = &cobra.Command{
 			common.WrongUsage(cmd)
 		}
 		cartID := args[0]
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		d, err := client.OrderSummaryCart(cartID)
@@ -28,7 +28,7 @@ var CmdDomain = &cobra.Command{
 		}
 		domain := args[0]
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		cart, err := client.OrderCreateCart(ovh.OrderCartCreateReq{OVHSubsidiary: "FR"})
@@ -1,7 +1,6 @@
 package agent
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -19,7 +18,7 @@ var cmdEasyHuntingAgentInfo = &cobra.Command{
 	Use:   "info",
 	Short: "Get info on a easyhunting: ovhcli telephony easyhunting hunting agent info --billingAccount=aa --serviceName=bb --agentID=cc",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		d, err := client.TelephonyOvhPabxHuntingAgentInfo(billingAccount, serviceName, agentID)
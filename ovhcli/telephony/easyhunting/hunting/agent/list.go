@@ -1,7 +1,6 @@
 package agent
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -24,7 +23,7 @@ var cmdEasyHuntingAgentList = &cobra.Command{
 	Short: "Hunting commands: ovhcli telephony easyhunting hunting agent list --help",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		services, err := client.TelephonyOvhPabxHuntingAgentList(billingAccount, serviceName, withDetails)
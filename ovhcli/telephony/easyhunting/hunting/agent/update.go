@@ -33,7 +33,7 @@ var cmdEasyHuntingAgentUpdate = &cobra.Command{
 	Use:   "update",
 	Short: "Get info on a easyhunting: ovhcli telephony easyhunting hunting agent info --billingAccount=aa --serviceName=bb --agentID=cc",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		a := ovh.TelephonyOvhPabxHuntingAgent{
@@ -1,7 +1,6 @@
 package hunting
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 	"github.com/admdwrf/ovhcli/ovhcli/telephony/easyhunting/hunting/agent"
 
@@ -27,7 +26,7 @@ var Cmd = &cobra.Command{
 	Long:  `Hunting commands: ovhcli telephony easyhunting hunting <command>`,
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		services, err := client.TelephonyOvhPabxHunting(billingAccount, serviceName)
@@ -1,7 +1,6 @@
 package easyhunting
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ var cmdEasyHuntingInfo = &cobra.Command{
 	Use:   "info",
 	Short: "Get info on a easyhunting: ovhcli telephony easyhunting info --billingAccount=aa --serviceName=bb",
 	Run: func(cmd *cobra.Command, args []string) {
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		d, err := client.TelephonyEasyHuntingInfo(billingAccount, serviceName)
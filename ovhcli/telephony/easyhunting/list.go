@@ -1,7 +1,6 @@
 package easyhunting
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -20,7 +19,7 @@ var cmdEasyHuntingList = &cobra.Command{
 	Short: "List all telephony billing account: ovhcli telephony easyhunting list --billingAccount=<billingAccount>",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		services, err := client.TelephonyEasyHuntingList(billingAccount, withDetails)
@@ -1,7 +1,6 @@
 package telephony
 
 import (
-	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
 	"github.com/spf13/cobra"
@@ -18,7 +17,7 @@ var cmdServiceList = &cobra.Command{
 	Short: "List all telephony billing account: ovhcli telephony list",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		services, err := client.TelephonyListBillingAccount(withDetails)
@@ -0,0 +1,56 @@
+package vrack
+
+import (
+	"github.com/admdwrf/ovhcli/ovhcli/common"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Cmd.AddCommand(cmdVrackCloudProject)
+	cmdVrackCloudProject.AddCommand(cmdVrackCloudProjectList)
+	cmdVrackCloudProject.AddCommand(cmdVrackCloudProjectAdd)
+}
+
+var cmdVrackCloudProject = &cobra.Command{
+	Use:   "cloud-project",
+	Short: "Cloud projects of a vrack: ovhcli vrack cloud-project --help",
+	Long:  `Cloud projects of a vrack: ovhcli vrack cloud-project <command>`,
+}
+
+var cmdVrackCloudProjectList = &cobra.Command{
+	Use:   "list <vrack>",
+	Short: "List the cloud projects of a vrack: ovhcli vrack cloud-project list <vrack>",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			common.WrongUsage(cmd)
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+
+		projects, err := client.VrackCloudProjects(args[0])
+		common.Check(err)
+		common.FormatOutputDef(projects)
+	},
+}
+
+var cmdVrackCloudProjectAdd = &cobra.Command{
+	Use:   "add <vrack> <project>",
+	Short: "Add a cloud project, by ID or name, to a vrack for its private networks: ovhcli vrack cloud-project add <vrack> <project>",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 2 {
+			common.WrongUsage(cmd)
+		}
+
+		client, err := common.NewClient()
+		common.Check(err)
+
+		project, err := client.CloudProjectInfoByName(args[1])
+		common.Check(err)
+
+		task, err := client.VrackAddCloudProject(args[0], project.ID)
+		common.Check(err)
+		common.FormatOutputDef(task)
+	},
+}
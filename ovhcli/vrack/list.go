@@ -1,6 +1,8 @@
 package vrack
 
 import (
+	"context"
+
 	ovh "github.com/admdwrf/ovhcli"
 	"github.com/admdwrf/ovhcli/ovhcli/common"
 
@@ -18,20 +20,17 @@ var cmdVrackList = &cobra.Command{
 	Short: "List all vrack: ovhcli vrack list",
 	Run: func(cmd *cobra.Command, args []string) {
 
-		client, err := ovh.NewClient()
+		client, err := common.NewClient()
 		common.Check(err)
 
 		vracks, err := client.VrackList()
 		common.Check(err)
 
 		if withDetails {
-			vracksComplete := []ovh.Vrack{}
-			for _, vrack := range vracks {
-				v, err := client.VrackInfo(vrack.Name)
-				common.Check(err)
-				vracksComplete = append(vracksComplete, *v)
-			}
-			vracks = vracksComplete
+			vracks, err = ovh.FetchDetails(context.Background(), client, vracks, func(ctx context.Context, vrack ovh.Vrack) (*ovh.Vrack, error) {
+				return client.VrackInfoCtx(ctx, vrack.Name)
+			})
+			common.Check(err)
 		}
 
 		common.FormatOutputDef(vracks)
@@ -0,0 +1,37 @@
+package ovhtest
+
+import (
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// AddContainersService adds a containers service. A name is generated if empty.
+func (s *Server) AddContainersService(service ovh.ContainersService) ovh.ContainersService {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if service.Name == "" {
+		service.Name = s.nextID("caas")
+	}
+	if service.State == "" {
+		service.State = "READY"
+	}
+	s.containers[service.Name] = &service
+	return service
+}
+
+func (s *Server) registerCaas() {
+	s.handle("GET /caas/containers", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.containers))
+	})
+
+	s.handle("GET /caas/containers/{serviceName}", func(w http.ResponseWriter, r *http.Request) {
+		service, ok := s.containers[r.PathValue("serviceName")]
+		if !ok {
+			writeNotFound(w, "containers service", r.PathValue("serviceName"))
+			return
+		}
+		writeJSON(w, http.StatusOK, service)
+	})
+}
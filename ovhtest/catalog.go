@@ -0,0 +1,31 @@
+package ovhtest
+
+import (
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// SetCatalog sets the public cloud catalog of the subsidiary of its locale
+func (s *Server) SetCatalog(catalog ovh.Catalog) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.catalogs[catalog.Locale.Subsidiary] = &catalog
+}
+
+func (s *Server) registerCatalog() {
+	s.handle("GET /order/catalog/public/cloud", func(w http.ResponseWriter, r *http.Request) {
+		subsidiary := r.URL.Query().Get("ovhSubsidiary")
+		if subsidiary == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: ovhSubsidiary")
+			return
+		}
+		catalog, ok := s.catalogs[subsidiary]
+		if !ok {
+			writeNotFound(w, "catalog", subsidiary)
+			return
+		}
+		writeJSON(w, http.StatusOK, catalog)
+	})
+}
@@ -0,0 +1,666 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// cloudProject is the state of a cloud project
+type cloudProject struct {
+	project   ovh.Project
+	regions   map[string]*ovh.Region
+	images    map[string]*ovh.Image
+	snapshots map[string]*ovh.Image
+	flavors   map[string]*ovh.Flavor
+	sshkeys   map[string]*ovh.Sshkey
+	instances map[string]*ovh.Instance
+	volumes   map[string]*ovh.Volume
+	// volumeSnapshots are the snapshots of volumes
+	volumeSnapshots map[string]*ovh.VolumeSnapshot
+	// nextStatus is the status of instances, snapshots, volumes, private
+	// networks, failover IPs and users after they are read
+	nextStatus map[string]string
+	// userData is the user data instances were created with, and
+	// consoleOutputs their console output
+	userData        map[string]string
+	consoleOutputs  map[string]string
+	publicNetworks  map[string]*ovh.Network
+	privateNetworks map[string]*ovh.Network
+	// subnets are the subnets of private networks, by network
+	subnets     map[string][]*ovh.Subnet
+	failoverIPs map[string]*ovh.FailoverIP
+	users       map[int]*ovh.User
+	// s3Credentials are the S3 credentials of the users, by access key
+	s3Credentials map[string]*ovh.S3Credentials
+	containers    map[string]*ovh.Container
+	// instanceGroups are the groups of instances, with the IDs of their members
+	instanceGroups map[string]*ovh.InstanceGroup
+	// quotas are the limits set by SetQuota, by region
+	quotas map[string]*ovh.Quota
+	// usage and forecast are the current usage and its forecast, and
+	// usageHistory the past usages by ID
+	usage        ovh.Usage
+	forecast     ovh.Usage
+	usageHistory map[string]*ovh.Usage
+}
+
+// AddProject adds a cloud project. An ID is generated if empty.
+func (s *Server) AddProject(project ovh.Project) ovh.Project {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if project.ID == "" {
+		project.ID = s.nextID("project")
+	}
+	if project.Status == "" {
+		project.Status = "ok"
+	}
+	p := s.cloudProject(project.ID)
+	p.project = project
+	return project
+}
+
+// AddRegion adds a region to a cloud project
+func (s *Server) AddRegion(projectID string, region ovh.Region) ovh.Region {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if region.Status == "" {
+		region.Status = "UP"
+	}
+	s.cloudProject(projectID).regions[region.Name] = &region
+	return region
+}
+
+// AddImage adds an image to a cloud project. An ID is generated if empty.
+func (s *Server) AddImage(projectID string, image ovh.Image) ovh.Image {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if image.ID == "" {
+		image.ID = s.nextID("image")
+	}
+	if image.Visibility == "" {
+		image.Visibility = "public"
+	}
+	s.cloudProject(projectID).images[image.ID] = &image
+	return image
+}
+
+// AddSnapshot adds a snapshot to a cloud project. An ID is generated if empty.
+func (s *Server) AddSnapshot(projectID string, snapshot ovh.Image) ovh.Image {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if snapshot.ID == "" {
+		snapshot.ID = s.nextID("snapshot")
+	}
+	if snapshot.Visibility == "" {
+		snapshot.Visibility = "private"
+	}
+	if snapshot.Status == "" {
+		snapshot.Status = "active"
+	}
+	s.cloudProject(projectID).snapshots[snapshot.ID] = &snapshot
+	return snapshot
+}
+
+// AddFlavor adds a flavor to a cloud project. An ID is generated if empty.
+func (s *Server) AddFlavor(projectID string, flavor ovh.Flavor) ovh.Flavor {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if flavor.ID == "" {
+		flavor.ID = s.nextID("flavor")
+	}
+	s.cloudProject(projectID).flavors[flavor.ID] = &flavor
+	return flavor
+}
+
+// AddSSHKey adds an SSH key to a cloud project. An ID is generated if empty.
+func (s *Server) AddSSHKey(projectID string, sshkey ovh.Sshkey) ovh.Sshkey {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if sshkey.ID == "" {
+		sshkey.ID = s.nextID("sshkey")
+	}
+	if sshkey.Regions == nil {
+		sshkey.Regions = []string{}
+	}
+	if sshkey.Fingerprint == "" {
+		sshkey.Fingerprint, _ = ovh.SSHKeyFingerprint(sshkey.PublicKey)
+	}
+	s.cloudProject(projectID).sshkeys[sshkey.ID] = &sshkey
+	return sshkey
+}
+
+// AddInstance adds an instance to a cloud project. An ID is generated if empty.
+func (s *Server) AddInstance(projectID string, instance ovh.Instance) ovh.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if instance.ID == "" {
+		instance.ID = s.nextID("instance")
+	}
+	if instance.Status == "" {
+		instance.Status = "ACTIVE"
+	}
+	s.cloudProject(projectID).instances[instance.ID] = &instance
+	return instance
+}
+
+// AddVolume adds a volume to a cloud project. An ID is generated if empty.
+func (s *Server) AddVolume(projectID string, volume ovh.Volume) ovh.Volume {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if volume.ID == "" {
+		volume.ID = s.nextID("volume")
+	}
+	if volume.Status == "" {
+		volume.Status = "available"
+	}
+	if volume.Type == "" {
+		volume.Type = ovh.VolumeClassic
+	}
+	if volume.AttachedTo == nil {
+		volume.AttachedTo = []string{}
+	}
+	s.cloudProject(projectID).volumes[volume.ID] = &volume
+	return volume
+}
+
+// AddPublicNetwork adds a public network to a cloud project. An ID is generated if empty.
+func (s *Server) AddPublicNetwork(projectID string, network ovh.Network) ovh.Network {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if network.ID == "" {
+		network.ID = s.nextID("ext-net")
+	}
+	network.Type = "public"
+	s.cloudProject(projectID).publicNetworks[network.ID] = &network
+	return network
+}
+
+// AddPrivateNetwork adds a private network to a cloud project. An ID is generated if empty.
+func (s *Server) AddPrivateNetwork(projectID string, network ovh.Network) ovh.Network {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if network.ID == "" {
+		network.ID = s.nextID(fmt.Sprintf("pn-%d", network.VlanID))
+	}
+	network.Type = "private"
+	s.cloudProject(projectID).privateNetworks[network.ID] = &network
+	return network
+}
+
+// AddFailoverIP adds a failover IP to a cloud project. An ID is generated if empty.
+func (s *Server) AddFailoverIP(projectID string, ip ovh.FailoverIP) ovh.FailoverIP {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if ip.ID == "" {
+		ip.ID = s.nextID("failover")
+	}
+	if ip.Block == "" {
+		ip.Block = ip.IP + "/32"
+	}
+	if ip.Status == "" {
+		ip.Status = "ok"
+	}
+	if ip.SubType == "" {
+		ip.SubType = "cloud"
+	}
+	s.cloudProject(projectID).failoverIPs[ip.ID] = &ip
+	return ip
+}
+
+// AddUser adds an OpenStack user to a cloud project. An ID is generated if empty.
+func (s *Server) AddUser(projectID string, user ovh.User) ovh.User {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if user.ID == 0 {
+		user.ID = s.nextIntID()
+	}
+	if user.Status == "" {
+		user.Status = "ok"
+	}
+	s.cloudProject(projectID).users[user.ID] = &user
+	return user
+}
+
+// AddContainer adds a storage container to a cloud project. An ID is generated
+// if empty, and the container is private unless its type is set.
+func (s *Server) AddContainer(projectID string, container ovh.Container) ovh.Container {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if container.ID == "" {
+		container.ID = s.nextID("container")
+	}
+	if container.ContainerType == "" {
+		container.ContainerType = ovh.ContainerPrivate
+	}
+	container.Public = container.ContainerType != ovh.ContainerPrivate
+	if container.StaticURL == "" {
+		container.StaticURL = staticURL(projectID, &container)
+	}
+	s.cloudProject(projectID).containers[container.ID] = &container
+	return container
+}
+
+// SetQuota sets the limits of a cloud project in the region of quota, enforced
+// on instance creation. Their usage is computed from the instances and volumes.
+func (s *Server) SetQuota(projectID string, quota ovh.Quota) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cloudProject(projectID).quotas[quota.Region] = &quota
+}
+
+// SetUsage sets the current usage of a cloud project and its forecast
+func (s *Server) SetUsage(projectID string, usage, forecast ovh.Usage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	p := s.cloudProject(projectID)
+	p.usage = usage
+	p.forecast = forecast
+}
+
+// AddUsageHistory adds a past usage to a cloud project. An ID is generated if empty.
+func (s *Server) AddUsageHistory(projectID string, usage ovh.Usage) ovh.Usage {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if usage.ID == "" {
+		usage.ID = s.nextID("usage")
+	}
+	s.cloudProject(projectID).usageHistory[usage.ID] = &usage
+	return usage
+}
+
+// SetInstanceStatus changes the status of an instance, to simulate its
+// progress or its failure
+func (s *Server) SetInstanceStatus(projectID, instanceID, status string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if instance, ok := s.cloudProject(projectID).instances[instanceID]; ok {
+		instance.Status = status
+	}
+}
+
+// InstanceUserData returns the user data an instance was created with
+func (s *Server) InstanceUserData(projectID, instanceID string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.cloudProject(projectID).userData[instanceID]
+}
+
+// cloudProject returns the state of a project, created if needed
+func (s *Server) cloudProject(projectID string) *cloudProject {
+	p, ok := s.projects[projectID]
+	if !ok {
+		p = &cloudProject{
+			project:         ovh.Project{ID: projectID, Status: "ok"},
+			regions:         map[string]*ovh.Region{},
+			images:          map[string]*ovh.Image{},
+			snapshots:       map[string]*ovh.Image{},
+			flavors:         map[string]*ovh.Flavor{},
+			sshkeys:         map[string]*ovh.Sshkey{},
+			instances:       map[string]*ovh.Instance{},
+			volumes:         map[string]*ovh.Volume{},
+			volumeSnapshots: map[string]*ovh.VolumeSnapshot{},
+			nextStatus:      map[string]string{},
+			userData:        map[string]string{},
+			consoleOutputs:  map[string]string{},
+			publicNetworks:  map[string]*ovh.Network{},
+			privateNetworks: map[string]*ovh.Network{},
+			subnets:         map[string][]*ovh.Subnet{},
+			failoverIPs:     map[string]*ovh.FailoverIP{},
+			users:           map[int]*ovh.User{},
+			s3Credentials:   map[string]*ovh.S3Credentials{},
+			containers:      map[string]*ovh.Container{},
+			instanceGroups:  map[string]*ovh.InstanceGroup{},
+			quotas:          map[string]*ovh.Quota{},
+			usageHistory:    map[string]*ovh.Usage{},
+		}
+		s.projects[projectID] = p
+	}
+	return p
+}
+
+// SetConsoleOutput sets the console output of an instance, served by the
+// OpenStack compute API
+func (s *Server) SetConsoleOutput(projectID, instanceID, output string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cloudProject(projectID).consoleOutputs[instanceID] = output
+}
+
+// projectOr404 returns the project of the request, or answers a 404
+func (s *Server) projectOr404(w http.ResponseWriter, r *http.Request) *cloudProject {
+	p, ok := s.projects[r.PathValue("projectID")]
+	if !ok {
+		writeNotFound(w, "project", r.PathValue("projectID"))
+	}
+	return p
+}
+
+// inRegion filters a map of images or flavors on the region query parameter
+func inRegion[V any](m map[string]*V, r *http.Request, region func(*V) string) []V {
+	res := []V{}
+	for _, k := range sortedKeys(m) {
+		if q := r.URL.Query().Get("region"); q != "" && region(m[k]) != q {
+			continue
+		}
+		res = append(res, *m[k])
+	}
+	return res
+}
+
+func (s *Server) registerCloud() {
+	s.handle("GET /cloud/project", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.projects))
+	})
+
+	s.handle("GET /cloud/project/{projectID}", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, p.project)
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/region", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, sortedKeys(p.regions))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/region/{region}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		region, ok := p.regions[r.PathValue("region")]
+		if !ok {
+			writeNotFound(w, "region", r.PathValue("region"))
+			return
+		}
+		writeJSON(w, http.StatusOK, region)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/instance", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.instances, r, func(i *ovh.Instance) string { return i.Region }))
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/instance", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := ovh.InstanceCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Name == "" || req.Region == "" {
+			writeError(w, http.StatusBadRequest, "Missing name or region")
+			return
+		}
+		flavor, ok := p.flavors[req.FlavorID]
+		if !ok {
+			writeNotFound(w, "flavor", req.FlavorID)
+			return
+		}
+		if !checkInstanceQuota(w, p, req.Region, flavor) {
+			return
+		}
+		var image *ovh.Image
+		if req.VolumeID == "" || req.ImageID != "" {
+			if image = imageOr404(w, p, req.ImageID); image == nil {
+				return
+			}
+		}
+		var volume *ovh.Volume
+		if req.VolumeID != "" {
+			if volume, ok = p.volumes[req.VolumeID]; !ok {
+				writeNotFound(w, "volume", req.VolumeID)
+				return
+			}
+			if !volume.Bootable || volume.Status != "available" {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Volume %s is not an available bootable volume", volume.ID))
+				return
+			}
+		}
+		instance := &ovh.Instance{
+			ID:      s.nextID("instance"),
+			Name:    req.Name,
+			Region:  req.Region,
+			Status:  "BUILD",
+			Created: time.Now().UTC().Format(time.RFC3339),
+			Flavor:  flavor,
+			Image:   image,
+		}
+		if req.SSHKeyID != "" {
+			sshkey, ok := p.sshkeys[req.SSHKeyID]
+			if !ok {
+				writeNotFound(w, "SSH key", req.SSHKeyID)
+				return
+			}
+			instance.Sshkey = sshkey
+		}
+		var group *ovh.InstanceGroup
+		if req.GroupID != "" {
+			if group, ok = p.instanceGroups[req.GroupID]; !ok {
+				writeNotFound(w, "instance group", req.GroupID)
+				return
+			}
+			if group.Region != req.Region {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Instance group %s is not in region %s", group.ID, req.Region))
+				return
+			}
+		}
+		ips := []ovh.IP{{IP: fmt.Sprintf("192.0.2.%d", len(p.instances)+1), Type: "public", Version: 4}}
+		for i, n := range req.Networks {
+			if _, ok := p.publicNetworks[n.NetworkID]; ok {
+				continue
+			}
+			if _, ok := p.privateNetworks[n.NetworkID]; !ok {
+				writeNotFound(w, "network", n.NetworkID)
+				return
+			}
+			ip := n.IP
+			if ip == "" {
+				ip = fmt.Sprintf("10.%d.0.%d", i, len(p.instances)+2)
+			}
+			ips = append(ips, ovh.IP{IP: ip, NetworkID: n.NetworkID, Type: "private", Version: 4})
+		}
+		if req.MonthlyBilling {
+			instance.MonthlyBilling = &ovh.InstanceMonthlyBilling{Status: "activationPending"}
+		}
+		if volume != nil {
+			volume.Status = "in-use"
+			volume.AttachedTo = []string{instance.ID}
+		}
+		p.instances[instance.ID] = instance
+		p.userData[instance.ID] = req.UserData
+		if group != nil {
+			group.InstanceIDs = append(group.InstanceIDs, instance.ID)
+		}
+		writeJSON(w, http.StatusOK, instance)
+
+		// the instance is built by the time it is read again
+		built := *instance
+		built.Status = "ACTIVE"
+		built.IPAddresses = ips
+		if built.MonthlyBilling != nil {
+			built.MonthlyBilling = &ovh.InstanceMonthlyBilling{Since: built.Created, Status: "ok"}
+		}
+		p.instances[instance.ID] = &built
+	})
+
+	s.handle("GET /cloud/project/{projectID}/instance/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		instance, ok := p.instances[r.PathValue("instanceID")]
+		if !ok {
+			writeNotFound(w, "instance", r.PathValue("instanceID"))
+			return
+		}
+		writeJSON(w, http.StatusOK, instance)
+
+		if status, ok := p.nextStatus[instance.ID]; ok {
+			instance.Status = status
+			delete(p.nextStatus, instance.ID)
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/instance/{instanceID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		if _, ok := p.instances[r.PathValue("instanceID")]; !ok {
+			writeNotFound(w, "instance", r.PathValue("instanceID"))
+			return
+		}
+		delete(p.instances, r.PathValue("instanceID"))
+		p.removeGroupMember(r.PathValue("instanceID"))
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/network/public", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.publicNetworks, r, func(*ovh.Network) string { return "" }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/network/private", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.privateNetworks, r, func(*ovh.Network) string { return "" }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/user", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		users := []ovh.User{}
+		for _, id := range sortedIntKeys(p.users) {
+			user := *p.userStatus(id)
+			user.Password = ""
+			users = append(users, user)
+		}
+		writeJSON(w, http.StatusOK, users)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := struct {
+			Description string `json:"description"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		user := &ovh.User{
+			ID:           s.nextIntID(),
+			Description:  req.Description,
+			Status:       "creating",
+			CreationDate: time.Now().UTC().Truncate(time.Second),
+		}
+		user.Username = fmt.Sprintf("user-%d", user.ID)
+		user.Password = fmt.Sprintf("password-%d", user.ID)
+		p.users[user.ID] = user
+		p.nextStatus[strconv.Itoa(user.ID)] = "ok"
+		writeJSON(w, http.StatusOK, user)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/sshkey", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.sshkeys, r, func(*ovh.Sshkey) string { return "" }))
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/sshkey", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		sshkey := &ovh.Sshkey{}
+		if !readJSON(w, r, sshkey) {
+			return
+		}
+		if !strings.HasPrefix(sshkey.PublicKey, "ssh-") && !strings.HasPrefix(sshkey.PublicKey, "ecdsa-") {
+			writeError(w, http.StatusBadRequest, "Invalid public key")
+			return
+		}
+		sshkey.ID = s.nextID("sshkey")
+		sshkey.Regions = []string{}
+		sshkey.Fingerprint, _ = ovh.SSHKeyFingerprint(sshkey.PublicKey)
+		p.sshkeys[sshkey.ID] = sshkey
+		writeJSON(w, http.StatusOK, sshkey)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/sshkey/{sshkeyID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		sshkey, ok := p.sshkeys[r.PathValue("sshkeyID")]
+		if !ok {
+			writeNotFound(w, "SSH key", r.PathValue("sshkeyID"))
+			return
+		}
+		writeJSON(w, http.StatusOK, sshkey)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/sshkey/{sshkeyID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		if _, ok := p.sshkeys[r.PathValue("sshkeyID")]; !ok {
+			writeNotFound(w, "SSH key", r.PathValue("sshkeyID"))
+			return
+		}
+		delete(p.sshkeys, r.PathValue("sshkeyID"))
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/image", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.images, r, func(i *ovh.Image) string { return i.Region }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.snapshots, r, func(i *ovh.Image) string { return i.Region }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/flavor", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.flavors, r, func(f *ovh.Flavor) string { return f.Region }))
+		}
+	})
+}
@@ -0,0 +1,199 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// queueApp is the state of a queue application
+type queueApp struct {
+	app         ovh.DBaasQueueApp
+	serviceInfo ovh.DBaasQueueServiceInfo
+	metrics     ovh.DBaasQueueMetricsAccount
+	keys        map[string]*ovh.DBaasQueueKey
+	roles       map[string]*ovh.DBaasQueueRole
+	regions     map[string]*ovh.DBaasQueueRegion
+	topics      map[string]*ovh.DBaasQueueTopic
+	users       map[string]*ovh.DBaasQueueUser
+}
+
+// AddQueueApp adds a queue application. An ID is generated if empty.
+func (s *Server) AddQueueApp(app ovh.DBaasQueueApp) ovh.DBaasQueueApp {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if app.ID == "" {
+		app.ID = s.nextID("queue")
+	}
+	if app.AppStatus == "" {
+		app.AppStatus = "active"
+	}
+	s.queueApp(app.ID).app = app
+	return app
+}
+
+// SetQueueServiceInfo sets the service information of a queue application
+func (s *Server) SetQueueServiceInfo(appID string, info ovh.DBaasQueueServiceInfo) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queueApp(appID).serviceInfo = info
+}
+
+// SetQueueMetricsAccount sets the metrics account of a queue application
+func (s *Server) SetQueueMetricsAccount(appID string, account ovh.DBaasQueueMetricsAccount) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queueApp(appID).metrics = account
+}
+
+// AddQueueKey adds a key to a queue application. An ID is generated if empty.
+func (s *Server) AddQueueKey(appID string, key ovh.DBaasQueueKey) ovh.DBaasQueueKey {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if key.ID == "" {
+		key.ID = s.nextID("key")
+	}
+	s.queueApp(appID).keys[key.ID] = &key
+	return key
+}
+
+// AddQueueRole adds a role to a queue application
+func (s *Server) AddQueueRole(appID string, role ovh.DBaasQueueRole) ovh.DBaasQueueRole {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queueApp(appID).roles[role.Name] = &role
+	return role
+}
+
+// AddQueueRegion adds a region to a queue application. An ID is generated if empty.
+func (s *Server) AddQueueRegion(appID string, region ovh.DBaasQueueRegion) ovh.DBaasQueueRegion {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if region.ID == "" {
+		region.ID = s.nextID("region")
+	}
+	s.queueApp(appID).regions[region.ID] = &region
+	return region
+}
+
+// AddQueueTopic adds a topic to a queue application
+func (s *Server) AddQueueTopic(appID string, topic ovh.DBaasQueueTopic) ovh.DBaasQueueTopic {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queueApp(appID).topics[topic.ID] = &topic
+	return topic
+}
+
+// AddQueueUser adds a user to a queue application. An ID is generated if empty.
+func (s *Server) AddQueueUser(appID string, user ovh.DBaasQueueUser) ovh.DBaasQueueUser {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if user.ID == "" {
+		user.ID = s.nextID("user")
+	}
+	s.queueApp(appID).users[user.ID] = &user
+	return user
+}
+
+// queueApp returns the state of a queue application, created if needed
+func (s *Server) queueApp(appID string) *queueApp {
+	q, ok := s.queues[appID]
+	if !ok {
+		q = &queueApp{
+			app:     ovh.DBaasQueueApp{ID: appID, AppStatus: "active"},
+			keys:    map[string]*ovh.DBaasQueueKey{},
+			roles:   map[string]*ovh.DBaasQueueRole{},
+			regions: map[string]*ovh.DBaasQueueRegion{},
+			topics:  map[string]*ovh.DBaasQueueTopic{},
+			users:   map[string]*ovh.DBaasQueueUser{},
+		}
+		s.queues[appID] = q
+	}
+	return q
+}
+
+// queueAppOr404 returns the queue application of the request, or answers a 404
+func (s *Server) queueAppOr404(w http.ResponseWriter, r *http.Request) *queueApp {
+	q, ok := s.queues[r.PathValue("serviceName")]
+	if !ok {
+		writeNotFound(w, "application", r.PathValue("serviceName"))
+	}
+	return q
+}
+
+// handleQueueCollection registers the listing and details routes of a
+// collection of a queue application
+func handleQueueCollection[V any](s *Server, name, kind string, collection func(*queueApp) map[string]*V) {
+	s.handle(fmt.Sprintf("GET /dbaas/queue/{serviceName}/%s", name), func(w http.ResponseWriter, r *http.Request) {
+		if q := s.queueAppOr404(w, r); q != nil {
+			writeJSON(w, http.StatusOK, sortedKeys(collection(q)))
+		}
+	})
+
+	s.handle(fmt.Sprintf("GET /dbaas/queue/{serviceName}/%s/{id}", name), func(w http.ResponseWriter, r *http.Request) {
+		q := s.queueAppOr404(w, r)
+		if q == nil {
+			return
+		}
+		v, ok := collection(q)[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, kind, r.PathValue("id"))
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	})
+}
+
+func (s *Server) registerDBaasQueue() {
+	s.handle("GET /dbaas/queue", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.queues))
+	})
+
+	s.handle("GET /dbaas/queue/{serviceName}", func(w http.ResponseWriter, r *http.Request) {
+		if q := s.queueAppOr404(w, r); q != nil {
+			writeJSON(w, http.StatusOK, q.app)
+		}
+	})
+
+	s.handle("GET /dbaas/queue/{serviceName}/serviceInfos", func(w http.ResponseWriter, r *http.Request) {
+		if q := s.queueAppOr404(w, r); q != nil {
+			writeJSON(w, http.StatusOK, q.serviceInfo)
+		}
+	})
+
+	s.handle("GET /dbaas/queue/{serviceName}/metrics/account", func(w http.ResponseWriter, r *http.Request) {
+		if q := s.queueAppOr404(w, r); q != nil {
+			writeJSON(w, http.StatusOK, q.metrics)
+		}
+	})
+
+	handleQueueCollection(s, "key", "key", func(q *queueApp) map[string]*ovh.DBaasQueueKey { return q.keys })
+	handleQueueCollection(s, "role", "role", func(q *queueApp) map[string]*ovh.DBaasQueueRole { return q.roles })
+	handleQueueCollection(s, "region", "region", func(q *queueApp) map[string]*ovh.DBaasQueueRegion { return q.regions })
+	handleQueueCollection(s, "topic", "topic", func(q *queueApp) map[string]*ovh.DBaasQueueTopic { return q.topics })
+	handleQueueCollection(s, "user", "user", func(q *queueApp) map[string]*ovh.DBaasQueueUser { return q.users })
+
+	s.handle("POST /dbaas/queue/{serviceName}/user/{id}/changePassword", func(w http.ResponseWriter, r *http.Request) {
+		q := s.queueAppOr404(w, r)
+		if q == nil {
+			return
+		}
+		user, ok := q.users[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, "user", r.PathValue("id"))
+			return
+		}
+		res := *user
+		res.Password = fmt.Sprintf("password-%d", s.nextIntID())
+		writeJSON(w, http.StatusOK, res)
+	})
+}
@@ -0,0 +1,31 @@
+package ovhtest
+
+import (
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// AddDomain adds a domain
+func (s *Server) AddDomain(domain ovh.Domain) ovh.Domain {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.domains[domain.Domain] = &domain
+	return domain
+}
+
+func (s *Server) registerDomain() {
+	s.handle("GET /domain", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.domains))
+	})
+
+	s.handle("GET /domain/{domain}", func(w http.ResponseWriter, r *http.Request) {
+		domain, ok := s.domains[r.PathValue("domain")]
+		if !ok {
+			writeNotFound(w, "domain", r.PathValue("domain"))
+			return
+		}
+		writeJSON(w, http.StatusOK, domain)
+	})
+}
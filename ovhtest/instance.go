@@ -0,0 +1,170 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// instanceOr404 returns the project and the instance of the request, or answers a 404
+func (s *Server) instanceOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.Instance) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	instance, ok := p.instances[r.PathValue("instanceID")]
+	if !ok {
+		writeNotFound(w, "instance", r.PathValue("instanceID"))
+		return nil, nil
+	}
+	return p, instance
+}
+
+// imageOr404 returns an image or a snapshot of p, or answers a 404
+func imageOr404(w http.ResponseWriter, p *cloudProject, imageID string) *ovh.Image {
+	if image, ok := p.images[imageID]; ok {
+		return image
+	}
+	if snapshot, ok := p.snapshots[imageID]; ok {
+		return snapshot
+	}
+	writeNotFound(w, "image", imageID)
+	return nil
+}
+
+// checkInstanceStatus answers a 409 if the status of instance is not one of statuses
+func checkInstanceStatus(w http.ResponseWriter, instance *ovh.Instance, action string, statuses ...string) bool {
+	for _, status := range statuses {
+		if instance.Status == status {
+			return true
+		}
+	}
+	writeError(w, http.StatusConflict, fmt.Sprintf("Cannot '%s' instance %s while it is in status %s", action, instance.ID, instance.Status))
+	return false
+}
+
+// handleInstanceAction registers an action moving instances from one of
+// statuses to status
+func (s *Server) handleInstanceAction(action, status string, statuses ...string) {
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/"+action, func(w http.ResponseWriter, r *http.Request) {
+		_, instance := s.instanceOr404(w, r)
+		if instance == nil || !checkInstanceStatus(w, instance, action, statuses...) {
+			return
+		}
+		instance.Status = status
+		writeJSON(w, http.StatusOK, nil)
+	})
+}
+
+func (s *Server) registerCloudInstance() {
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/reboot", func(w http.ResponseWriter, r *http.Request) {
+		p, instance := s.instanceOr404(w, r)
+		if instance == nil {
+			return
+		}
+		req := ovh.RebootReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Type != ovh.RebootSoft && req.Type != ovh.RebootHard {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid reboot type: %s", req.Type))
+			return
+		}
+		if !checkInstanceStatus(w, instance, "reboot", "ACTIVE", "SHUTOFF") {
+			return
+		}
+		// the instance is back to ACTIVE once read
+		instance.Status = "REBOOT"
+		if req.Type == ovh.RebootHard {
+			instance.Status = "HARD_REBOOT"
+		}
+		p.nextStatus[instance.ID] = "ACTIVE"
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handleInstanceAction("start", "ACTIVE", "SHUTOFF")
+	s.handleInstanceAction("stop", "SHUTOFF", "ACTIVE")
+	s.handleInstanceAction("shelve", "SHELVED_OFFLOADED", "ACTIVE", "SHUTOFF")
+	s.handleInstanceAction("unshelve", "ACTIVE", "SHELVED_OFFLOADED")
+
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/rescueMode", func(w http.ResponseWriter, r *http.Request) {
+		p, instance := s.instanceOr404(w, r)
+		if instance == nil {
+			return
+		}
+		req := ovh.RescueReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if !req.Rescue {
+			if checkInstanceStatus(w, instance, "unrescue", "RESCUE") {
+				instance.Status = "ACTIVE"
+				writeJSON(w, http.StatusOK, ovh.RescueAdminPassword{})
+			}
+			return
+		}
+		if req.ImageID != "" && imageOr404(w, p, req.ImageID) == nil {
+			return
+		}
+		if checkInstanceStatus(w, instance, "rescue", "ACTIVE", "SHUTOFF") {
+			instance.Status = "RESCUE"
+			password := fmt.Sprintf("rescue-%d", s.nextIntID())
+			writeJSON(w, http.StatusOK, ovh.RescueAdminPassword{AdminPassword: &password})
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/reinstall", func(w http.ResponseWriter, r *http.Request) {
+		p, instance := s.instanceOr404(w, r)
+		if instance == nil {
+			return
+		}
+		req := ovh.ReinstallReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		image := imageOr404(w, p, req.ImageID)
+		if image == nil || !checkInstanceStatus(w, instance, "reinstall", "ACTIVE", "SHUTOFF") {
+			return
+		}
+		instance.Image = image
+		instance.Status = "ACTIVE"
+		writeJSON(w, http.StatusOK, instance)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/resize", func(w http.ResponseWriter, r *http.Request) {
+		p, instance := s.instanceOr404(w, r)
+		if instance == nil {
+			return
+		}
+		req := ovh.ResizeReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		flavor, ok := p.flavors[req.FlavorID]
+		if !ok {
+			writeNotFound(w, "flavor", req.FlavorID)
+			return
+		}
+		if instance.Flavor != nil && flavor.DiskSpaceGB < instance.Flavor.DiskSpaceGB {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Flavor %s has less disk than flavor %s", flavor.Name, instance.Flavor.Name))
+			return
+		}
+		if !checkInstanceStatus(w, instance, "resize", "ACTIVE", "SHUTOFF") {
+			return
+		}
+		instance.Flavor = flavor
+		writeJSON(w, http.StatusOK, instance)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/vnc", func(w http.ResponseWriter, r *http.Request) {
+		_, instance := s.instanceOr404(w, r)
+		if instance == nil || !checkInstanceStatus(w, instance, "vnc", "ACTIVE", "RESCUE") {
+			return
+		}
+		writeJSON(w, http.StatusOK, ovh.InstanceVNC{
+			Type: "novnc",
+			URL:  fmt.Sprintf("%s/vnc_auto.html?token=%s", s.URL, s.nextID("vnc")),
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// instanceGroupOr404 returns the project and the instance group of the request, or answers a 404
+func (s *Server) instanceGroupOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.InstanceGroup) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	group, ok := p.instanceGroups[r.PathValue("groupID")]
+	if !ok {
+		writeNotFound(w, "instance group", r.PathValue("groupID"))
+		return nil, nil
+	}
+	return p, group
+}
+
+// removeGroupMember removes an instance from the instance groups of p
+func (p *cloudProject) removeGroupMember(instanceID string) {
+	for _, group := range p.instanceGroups {
+		for i, id := range group.InstanceIDs {
+			if id == instanceID {
+				group.InstanceIDs = append(group.InstanceIDs[:i:i], group.InstanceIDs[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func (s *Server) registerCloudInstanceGroup() {
+	s.handle("GET /cloud/project/{projectID}/instance/group", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.instanceGroups, r, func(g *ovh.InstanceGroup) string { return g.Region }))
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/instance/group", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := ovh.InstanceGroupCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Name == "" || req.Region == "" {
+			writeError(w, http.StatusBadRequest, "Missing name or region")
+			return
+		}
+		if req.Policy != ovh.InstanceGroupAntiAffinity && req.Policy != ovh.InstanceGroupAffinity {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid policy: %s", req.Policy))
+			return
+		}
+		group := &ovh.InstanceGroup{
+			ID:          s.nextID("group"),
+			Name:        req.Name,
+			Region:      req.Region,
+			Type:        req.Policy,
+			InstanceIDs: []string{},
+		}
+		p.instanceGroups[group.ID] = group
+		writeJSON(w, http.StatusOK, group)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/instance/group/{groupID}", func(w http.ResponseWriter, r *http.Request) {
+		if _, group := s.instanceGroupOr404(w, r); group != nil {
+			writeJSON(w, http.StatusOK, group)
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/instance/group/{groupID}", func(w http.ResponseWriter, r *http.Request) {
+		p, group := s.instanceGroupOr404(w, r)
+		if group == nil {
+			return
+		}
+		delete(p.instanceGroups, group.ID)
+		writeJSON(w, http.StatusOK, nil)
+	})
+}
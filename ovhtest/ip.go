@@ -0,0 +1,68 @@
+package ovhtest
+
+import (
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// failoverIPOr404 returns the project and the failover IP of the request, or answers a 404
+func (s *Server) failoverIPOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.FailoverIP) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	ip, ok := p.failoverIPs[r.PathValue("ipID")]
+	if !ok {
+		writeNotFound(w, "failover IP", r.PathValue("ipID"))
+		return nil, nil
+	}
+	return p, ip
+}
+
+func (s *Server) registerCloudIP() {
+	s.handle("GET /cloud/project/{projectID}/ip/failover", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.failoverIPs, r, func(*ovh.FailoverIP) string { return "" }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/ip/failover/{ipID}", func(w http.ResponseWriter, r *http.Request) {
+		p, ip := s.failoverIPOr404(w, r)
+		if ip == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, ip)
+
+		if status, ok := p.nextStatus[ip.ID]; ok {
+			ip.Status = status
+			ip.Progress = 0
+			delete(p.nextStatus, ip.ID)
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/ip/failover/{ipID}/attach", func(w http.ResponseWriter, r *http.Request) {
+		p, ip := s.failoverIPOr404(w, r)
+		if ip == nil {
+			return
+		}
+		req := ovh.FailoverIPAttachReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if _, ok := p.instances[req.InstanceID]; !ok {
+			writeNotFound(w, "instance", req.InstanceID)
+			return
+		}
+		if ip.Status != "ok" {
+			writeError(w, http.StatusConflict, "An operation is already pending on failover IP "+ip.ID)
+			return
+		}
+		// the IP is routed once read
+		ip.RoutedTo = req.InstanceID
+		ip.Status = "operationPending"
+		ip.Progress = 50
+		p.nextStatus[ip.ID] = "ok"
+		writeJSON(w, http.StatusOK, ip)
+	})
+}
@@ -0,0 +1,187 @@
+package ovhtest
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// privateNetworkOr404 returns the project and the private network of the request, or answers a 404
+func (s *Server) privateNetworkOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.Network) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	network, ok := p.privateNetworks[r.PathValue("networkID")]
+	if !ok {
+		writeNotFound(w, "network", r.PathValue("networkID"))
+		return nil, nil
+	}
+	return p, network
+}
+
+// ipIn returns whether ip is a valid IP of subnet
+func ipIn(subnet *net.IPNet, ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && subnet.Contains(parsed)
+}
+
+func (s *Server) registerCloudNetwork() {
+	s.handle("POST /cloud/project/{projectID}/network/private", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := ovh.NetworkPrivateCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.VlanID < 0 || req.VlanID > 4000 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid VLAN ID: %d", req.VlanID))
+			return
+		}
+		for _, n := range p.privateNetworks {
+			if n.VlanID == req.VlanID {
+				writeError(w, http.StatusConflict, fmt.Sprintf("VLAN %d is already used by network %s", req.VlanID, n.ID))
+				return
+			}
+		}
+		regions := req.Regions
+		if len(regions) == 0 {
+			regions = sortedKeys(p.regions)
+		}
+		network := &ovh.Network{
+			ID:      s.nextID(fmt.Sprintf("pn-%d", req.VlanID)),
+			Name:    req.Name,
+			VlanID:  req.VlanID,
+			Type:    "private",
+			Status:  "BUILDING",
+			Regions: []ovh.NetworkRegion{},
+		}
+		for _, region := range regions {
+			network.Regions = append(network.Regions, ovh.NetworkRegion{Region: region, Status: "BUILDING"})
+		}
+		p.privateNetworks[network.ID] = network
+		// the network is active in its regions once read
+		p.nextStatus[network.ID] = "ACTIVE"
+		writeJSON(w, http.StatusOK, network)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/network/private/{networkID}", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, network)
+
+		if status, ok := p.nextStatus[network.ID]; ok {
+			network.Status = status
+			for i := range network.Regions {
+				network.Regions[i].Status = status
+			}
+			delete(p.nextStatus, network.ID)
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/network/private/{networkID}", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		delete(p.privateNetworks, network.ID)
+		delete(p.subnets, network.ID)
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/network/private/{networkID}/region", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		req := ovh.NetworkRegionReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		for _, region := range network.Regions {
+			if region.Region == req.Region {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Network %s is already in region %s", network.ID, req.Region))
+				return
+			}
+		}
+		network.Regions = append(network.Regions, ovh.NetworkRegion{Region: req.Region, Status: "BUILDING"})
+		p.nextStatus[network.ID] = "ACTIVE"
+		writeJSON(w, http.StatusOK, network)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/network/private/{networkID}/subnet", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		subnets := []ovh.Subnet{}
+		for _, subnet := range p.subnets[network.ID] {
+			subnets = append(subnets, *subnet)
+		}
+		writeJSON(w, http.StatusOK, subnets)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/network/private/{networkID}/subnet", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		req := ovh.SubnetCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		inRegion := false
+		for _, region := range network.Regions {
+			inRegion = inRegion || region.Region == req.Region
+		}
+		if !inRegion {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Network %s is not in region %s", network.ID, req.Region))
+			return
+		}
+		ip, cidr, err := net.ParseCIDR(req.Network)
+		if err != nil || ip.To4() == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid network: %s", req.Network))
+			return
+		}
+		if !ipIn(cidr, req.Start) || !ipIn(cidr, req.End) || bytes.Compare(net.ParseIP(req.Start), net.ParseIP(req.End)) > 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid range %s-%s of network %s", req.Start, req.End, req.Network))
+			return
+		}
+		subnet := &ovh.Subnet{
+			ID:      s.nextID("subnet"),
+			CIDR:    cidr.String(),
+			IPPools: []ovh.IPPool{{Region: req.Region, Network: cidr.String(), Start: req.Start, End: req.End, DHCP: req.DHCP}},
+		}
+		if !req.NoGateway {
+			gateway := make(net.IP, len(cidr.IP.To4()))
+			copy(gateway, cidr.IP.To4())
+			gateway[3]++
+			subnet.GatewayIP = gateway.String()
+		}
+		p.subnets[network.ID] = append(p.subnets[network.ID], subnet)
+		writeJSON(w, http.StatusOK, subnet)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/network/private/{networkID}/subnet/{subnetID}", func(w http.ResponseWriter, r *http.Request) {
+		p, network := s.privateNetworkOr404(w, r)
+		if network == nil {
+			return
+		}
+		subnets := p.subnets[network.ID]
+		for i, subnet := range subnets {
+			if subnet.ID == r.PathValue("subnetID") {
+				p.subnets[network.ID] = append(subnets[:i:i], subnets[i+1:]...)
+				writeJSON(w, http.StatusOK, nil)
+				return
+			}
+		}
+		writeNotFound(w, "subnet", r.PathValue("subnetID"))
+	})
+}
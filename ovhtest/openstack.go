@@ -0,0 +1,130 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// openStackPrefix is the path of the fake OpenStack APIs of the cloud projects
+const openStackPrefix = "/openstack/"
+
+// OpenStackAuthURL returns the URL of the fake Keystone v3 API, which
+// authenticates the users of cloud projects with their username and password
+func (s *Server) OpenStackAuthURL() string {
+	return s.URL + openStackPrefix + "identity/v3"
+}
+
+// serveOpenStack serves the OpenStack APIs, which are not signed like the OVH API
+func (s *Server) serveOpenStack(w http.ResponseWriter, r *http.Request) {
+	handler := s.match(r)
+	if handler == nil {
+		writeOpenStackError(w, http.StatusNotFound, "itemNotFound", fmt.Sprintf("Not found: %s %s", r.Method, r.URL.Path))
+		return
+	}
+	handler.ServeHTTP(w, r)
+}
+
+// writeOpenStackError answers an error wrapped in an object named after its
+// type, like OpenStack APIs
+func writeOpenStackError(w http.ResponseWriter, status int, kind, message string) {
+	writeJSON(w, status, map[string]interface{}{kind: map[string]interface{}{"code": status, "message": message}})
+}
+
+// openStackProjectOr401 returns the cloud project of the token of r, or
+// answers a 401
+func (s *Server) openStackProjectOr401(w http.ResponseWriter, r *http.Request) *cloudProject {
+	p, ok := s.projects[s.openStackTokens[r.Header.Get("X-Auth-Token")]]
+	if !ok {
+		writeOpenStackError(w, http.StatusUnauthorized, "error", "The request you have made requires authentication.")
+		return nil
+	}
+	return p
+}
+
+func (s *Server) registerOpenStack() {
+	s.handle("POST /openstack/identity/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			Auth struct {
+				Identity struct {
+					Password struct {
+						User struct {
+							Name     string `json:"name"`
+							Password string `json:"password"`
+						} `json:"user"`
+					} `json:"password"`
+				} `json:"identity"`
+				Scope struct {
+					Project struct {
+						ID string `json:"id"`
+					} `json:"project"`
+				} `json:"scope"`
+			} `json:"auth"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		user := req.Auth.Identity.Password.User
+		p := s.projects[req.Auth.Scope.Project.ID]
+		authenticated := false
+		if p != nil {
+			for _, u := range p.users {
+				authenticated = authenticated || (u.Username == user.Name && u.Password == user.Password && u.Status == "ok")
+			}
+		}
+		if !authenticated {
+			writeOpenStackError(w, http.StatusUnauthorized, "error", "The request you have made requires authentication.")
+			return
+		}
+
+		token := s.nextID("token")
+		s.openStackTokens[token] = p.project.ID
+		endpoints := []map[string]string{}
+		for _, region := range sortedKeys(p.regions) {
+			endpoints = append(endpoints, map[string]string{
+				"interface": "public",
+				"region":    region,
+				"url":       s.URL + openStackPrefix + "compute/" + region + "/v2.1",
+			})
+		}
+		w.Header().Set("X-Subject-Token", token)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{
+			"token": map[string]interface{}{
+				"project": map[string]string{"id": p.project.ID},
+				"catalog": []interface{}{map[string]interface{}{"type": "compute", "endpoints": endpoints}},
+			},
+		})
+	})
+
+	s.handle("POST /openstack/compute/{region}/v2.1/servers/{instanceID}/action", func(w http.ResponseWriter, r *http.Request) {
+		p := s.openStackProjectOr401(w, r)
+		if p == nil {
+			return
+		}
+		instance, ok := p.instances[r.PathValue("instanceID")]
+		if !ok || instance.Region != r.PathValue("region") {
+			writeOpenStackError(w, http.StatusNotFound, "itemNotFound", fmt.Sprintf("Instance %s could not be found.", r.PathValue("instanceID")))
+			return
+		}
+		req := struct {
+			ConsoleOutput *struct {
+				Length int `json:"length"`
+			} `json:"os-getConsoleOutput"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.ConsoleOutput == nil {
+			writeOpenStackError(w, http.StatusBadRequest, "badRequest", "Unsupported server action")
+			return
+		}
+		lines := strings.SplitAfter(p.consoleOutputs[instance.ID], "\n")
+		if lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		if length := req.ConsoleOutput.Length; length > 0 && length < len(lines) {
+			lines = lines[len(lines)-length:]
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"output": strings.Join(lines, "")})
+	})
+}
@@ -0,0 +1,510 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// cart is the state of an order cart
+type cart struct {
+	cart     ovh.OrderCart
+	assigned bool
+	items    map[int]*cartItem
+}
+
+// cartItem is the state of an item of an order cart
+type cartItem struct {
+	item           ovh.OrderCartItem
+	quantity       int
+	configurations map[int]*ovh.OrderCartConfigurationItem
+}
+
+// SetDomainOffers sets the offers proposed when ordering or transferring a
+// domain. The offers are the same for all domains.
+func (s *Server) SetDomainOffers(offers []ovh.OrderCartProductInformation) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cartOffers = offers
+}
+
+// SetDomainOptions sets the options proposed for the domains of a cart
+func (s *Server) SetDomainOptions(options []ovh.OrderCartGenericOptionDefinition) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cartOptions = options
+}
+
+// cartOr404 returns the cart of the request, or answers a 404
+func (s *Server) cartOr404(w http.ResponseWriter, r *http.Request) *cart {
+	c, ok := s.carts[r.PathValue("cartID")]
+	if !ok {
+		writeNotFound(w, "cart", r.PathValue("cartID"))
+	}
+	return c
+}
+
+// writableCartOr404 is cartOr404 for requests modifying the cart
+func (s *Server) writableCartOr404(w http.ResponseWriter, r *http.Request) *cart {
+	c := s.cartOr404(w, r)
+	if c != nil && c.cart.ReadOnly {
+		writeError(w, http.StatusForbidden, "This cart is read only")
+		return nil
+	}
+	return c
+}
+
+// itemOr404 returns the cart item of the request, or answers a 404
+func (s *Server) itemOr404(w http.ResponseWriter, r *http.Request, c *cart) *cartItem {
+	itemID, err := strconv.Atoi(r.PathValue("itemID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid itemId: "+r.PathValue("itemID"))
+		return nil
+	}
+	item, ok := c.items[itemID]
+	if !ok {
+		writeNotFound(w, "item", r.PathValue("itemID"))
+		return nil
+	}
+	return item
+}
+
+// offer returns the domain offer with the given ID, the first one if empty
+func (s *Server) offer(offerID string) (ovh.OrderCartProductInformation, bool) {
+	for _, offer := range s.cartOffers {
+		if offerID == "" || offer.OfferID == offerID {
+			return offer, true
+		}
+	}
+	return ovh.OrderCartProductInformation{}, false
+}
+
+// addItem adds an item to a cart
+func (s *Server) addItem(c *cart, item ovh.OrderCartItem, quantity int) *cartItem {
+	item.ItemID = s.nextIntID()
+	item.CartID = c.cart.CartID
+	if quantity == 0 {
+		quantity = 1
+	}
+	i := &cartItem{
+		item:           item,
+		quantity:       quantity,
+		configurations: map[int]*ovh.OrderCartConfigurationItem{},
+	}
+	c.items[item.ItemID] = i
+	c.cart.Items = append(c.cart.Items, item.ItemID)
+	return i
+}
+
+// order returns the summary of the items of a cart
+func (c *cart) order() ovh.Order {
+	order := ovh.Order{Details: []ovh.OrderDetail{}, Contracts: []ovh.OrderContract{}}
+	for _, id := range sortedIntKeys(c.items) {
+		item := c.items[id]
+		unitPrice := ovh.OrderPrice{CurrencyCode: "EUR"}
+		for _, p := range item.item.Prices {
+			if p.Label == "PRICE" || p.Label == "TOTAL" {
+				unitPrice = p.Price
+				break
+			}
+		}
+		totalPrice := unitPrice
+		totalPrice.Value = unitPrice.Value * float32(item.quantity)
+		totalPrice.Text = fmt.Sprintf("%.2f %s", totalPrice.Value, totalPrice.CurrencyCode)
+
+		order.Details = append(order.Details, ovh.OrderDetail{
+			Domain:      item.item.Settings.Domain,
+			DetailType:  "DURATION",
+			Quantity:    item.quantity,
+			UnitPrice:   unitPrice,
+			TotalPrice:  totalPrice,
+			Description: fmt.Sprintf("%s %s", item.item.ProductID, item.item.Settings.Domain),
+		})
+
+		order.Prices.WithoutTax.CurrencyCode = totalPrice.CurrencyCode
+		order.Prices.WithoutTax.Value += totalPrice.Value
+	}
+	order.Prices.Tax = ovh.OrderPrice{CurrencyCode: order.Prices.WithoutTax.CurrencyCode, Value: order.Prices.WithoutTax.Value * 0.2}
+	order.Prices.WithTax = ovh.OrderPrice{CurrencyCode: order.Prices.WithoutTax.CurrencyCode, Value: order.Prices.WithoutTax.Value * 1.2}
+	for _, p := range []*ovh.OrderPrice{&order.Prices.WithoutTax, &order.Prices.Tax, &order.Prices.WithTax} {
+		p.Text = fmt.Sprintf("%.2f %s", p.Value, p.CurrencyCode)
+	}
+	return order
+}
+
+// handleDomainProducts registers the routes listing the offers and options
+// of a domain product, and adding it to a cart
+func (s *Server) handleDomainProducts(product string) {
+	s.handle(fmt.Sprintf("GET /order/cart/{cartID}/%s", product), func(w http.ResponseWriter, r *http.Request) {
+		if s.cartOr404(w, r) == nil {
+			return
+		}
+		if r.URL.Query().Get("domain") == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: domain")
+			return
+		}
+		offers := []ovh.OrderCartProductInformation{}
+		offers = append(offers, s.cartOffers...)
+		writeJSON(w, http.StatusOK, offers)
+	})
+
+	s.handle(fmt.Sprintf("POST /order/cart/{cartID}/%s", product), func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		req := ovh.OrderPostDomainReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		offer, ok := s.offer(req.OfferID)
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Offer %s is not available for %s", req.OfferID, req.Domain))
+			return
+		}
+		duration := req.Duration
+		if duration == "" && len(offer.Duration) > 0 {
+			duration = offer.Duration[0]
+		}
+		item := s.addItem(c, ovh.OrderCartItem{
+			ProductID: product,
+			OfferID:   offer.OfferID,
+			Duration:  duration,
+			Prices:    offer.Prices,
+			Settings: ovh.OrderCartDomainSettings{
+				Domain:   req.Domain,
+				Offer:    offer.Offer,
+				Phase:    offer.Phase,
+				Quantity: req.Quantity,
+			},
+		}, req.Quantity)
+		writeJSON(w, http.StatusOK, item.item)
+	})
+
+	s.handle(fmt.Sprintf("GET /order/cart/{cartID}/%s/options", product), func(w http.ResponseWriter, r *http.Request) {
+		if s.cartOr404(w, r) == nil {
+			return
+		}
+		if r.URL.Query().Get("domain") == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: domain")
+			return
+		}
+		options := []ovh.OrderCartGenericOptionDefinition{}
+		options = append(options, s.cartOptions...)
+		writeJSON(w, http.StatusOK, options)
+	})
+
+	s.handle(fmt.Sprintf("POST /order/cart/{cartID}/%s/options", product), func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		req := ovh.OrderPostDomainOptionReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		parent, ok := c.items[req.ItemID]
+		if !ok {
+			writeNotFound(w, "item", strconv.Itoa(req.ItemID))
+			return
+		}
+		item := s.addItem(c, ovh.OrderCartItem{
+			ProductID:    product,
+			OfferID:      req.PlanCode,
+			ParentItemID: parent.item.ItemID,
+			Duration:     req.Duration,
+			Settings:     ovh.OrderCartDomainSettings{Domain: parent.item.Settings.Domain},
+		}, req.Quantity)
+		parent.item.Options = append(parent.item.Options, item.item.ItemID)
+		writeJSON(w, http.StatusOK, item.item)
+	})
+}
+
+func (s *Server) registerOrderCart() {
+	s.handle("GET /order/cart", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.carts))
+	})
+
+	s.handle("POST /order/cart", func(w http.ResponseWriter, r *http.Request) {
+		req := ovh.OrderCartCreateReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.OVHSubsidiary == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: ovhSubsidiary")
+			return
+		}
+		c := &cart{
+			cart: ovh.OrderCart{
+				CartID:      s.nextID("cart"),
+				Description: req.Description,
+				Expire:      req.Expire,
+				Items:       []int{},
+			},
+			items: map[int]*cartItem{},
+		}
+		if c.cart.Expire == nil {
+			expire := time.Now().UTC().Truncate(time.Second).Add(24 * time.Hour)
+			c.cart.Expire = &expire
+		}
+		s.carts[c.cart.CartID] = c
+		writeJSON(w, http.StatusOK, c.cart)
+	})
+
+	s.handle("GET /order/cart/{cartID}", func(w http.ResponseWriter, r *http.Request) {
+		if c := s.cartOr404(w, r); c != nil {
+			writeJSON(w, http.StatusOK, c.cart)
+		}
+	})
+
+	s.handle("PUT /order/cart/{cartID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		req := ovh.OrderCartUpdateReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Description != "" {
+			c.cart.Description = req.Description
+		}
+		if req.Expire != nil {
+			c.cart.Expire = req.Expire
+		}
+		writeJSON(w, http.StatusOK, c.cart)
+	})
+
+	s.handle("DELETE /order/cart/{cartID}", func(w http.ResponseWriter, r *http.Request) {
+		if s.cartOr404(w, r) == nil {
+			return
+		}
+		delete(s.carts, r.PathValue("cartID"))
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("POST /order/cart/{cartID}/assign", func(w http.ResponseWriter, r *http.Request) {
+		if c := s.cartOr404(w, r); c != nil {
+			c.assigned = true
+			writeJSON(w, http.StatusOK, nil)
+		}
+	})
+
+	s.handle("GET /order/cart/{cartID}/summary", func(w http.ResponseWriter, r *http.Request) {
+		if c := s.cartOr404(w, r); c != nil {
+			writeJSON(w, http.StatusOK, c.order())
+		}
+	})
+
+	s.handle("GET /order/cart/{cartID}/checkout", func(w http.ResponseWriter, r *http.Request) {
+		if c := s.cartOr404(w, r); c != nil {
+			order := c.order()
+			order.Contracts = append(order.Contracts, ovh.OrderContract{Name: "General terms", URL: s.URL + "/contracts/general"})
+			writeJSON(w, http.StatusOK, order)
+		}
+	})
+
+	s.handle("POST /order/cart/{cartID}/checkout", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		if !c.assigned {
+			writeError(w, http.StatusForbidden, "This cart is not assigned to your account")
+			return
+		}
+		if len(c.items) == 0 {
+			writeError(w, http.StatusBadRequest, "This cart is empty")
+			return
+		}
+		order := c.order()
+		order.OrderID = s.nextIntID()
+		order.URL = fmt.Sprintf("%s/order/%d", s.URL, order.OrderID)
+		c.cart.ReadOnly = true
+		writeJSON(w, http.StatusOK, order)
+	})
+
+	s.handle("GET /order/cart/{cartID}/item", func(w http.ResponseWriter, r *http.Request) {
+		if c := s.cartOr404(w, r); c != nil {
+			writeJSON(w, http.StatusOK, sortedIntKeys(c.items))
+		}
+	})
+
+	s.handle("GET /order/cart/{cartID}/item/{itemID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.cartOr404(w, r)
+		if c == nil {
+			return
+		}
+		if item := s.itemOr404(w, r, c); item != nil {
+			writeJSON(w, http.StatusOK, item.item)
+		}
+	})
+
+	s.handle("PUT /order/cart/{cartID}/item/{itemID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		req := struct {
+			Duration string `json:"duration"`
+			Quantity int    `json:"quantity"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Duration != "" {
+			item.item.Duration = req.Duration
+		}
+		if req.Quantity != 0 {
+			item.quantity = req.Quantity
+			item.item.Settings.Quantity = req.Quantity
+		}
+		writeJSON(w, http.StatusOK, item.item)
+	})
+
+	s.handle("DELETE /order/cart/{cartID}/item/{itemID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		delete(c.items, item.item.ItemID)
+		c.cart.Items = sortedIntKeys(c.items)
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /order/cart/{cartID}/item/{itemID}/configuration", func(w http.ResponseWriter, r *http.Request) {
+		c := s.cartOr404(w, r)
+		if c == nil {
+			return
+		}
+		if item := s.itemOr404(w, r, c); item != nil {
+			writeJSON(w, http.StatusOK, sortedIntKeys(item.configurations))
+		}
+	})
+
+	s.handle("POST /order/cart/{cartID}/item/{itemID}/configuration", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		config := &ovh.OrderCartConfigurationItem{}
+		if !readJSON(w, r, config) {
+			return
+		}
+		if config.Label == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: label")
+			return
+		}
+		config.ID = s.nextIntID()
+		item.configurations[config.ID] = config
+		item.item.Configurations = sortedIntKeys(item.configurations)
+		writeJSON(w, http.StatusOK, config)
+	})
+
+	s.handle("GET /order/cart/{cartID}/item/{itemID}/configuration/{configID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.cartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		configID, _ := strconv.Atoi(r.PathValue("configID"))
+		config, ok := item.configurations[configID]
+		if !ok {
+			writeNotFound(w, "configuration", r.PathValue("configID"))
+			return
+		}
+		writeJSON(w, http.StatusOK, config)
+	})
+
+	s.handle("DELETE /order/cart/{cartID}/item/{itemID}/configuration/{configID}", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		configID, _ := strconv.Atoi(r.PathValue("configID"))
+		if _, ok := item.configurations[configID]; !ok {
+			writeNotFound(w, "configuration", r.PathValue("configID"))
+			return
+		}
+		delete(item.configurations, configID)
+		item.item.Configurations = sortedIntKeys(item.configurations)
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /order/cart/{cartID}/item/{itemID}/requiredConfiguration", func(w http.ResponseWriter, r *http.Request) {
+		c := s.cartOr404(w, r)
+		if c == nil {
+			return
+		}
+		item := s.itemOr404(w, r, c)
+		if item == nil {
+			return
+		}
+		required := []ovh.OrderCartConfigurationRequirements{}
+		if offer, ok := s.offer(item.item.OfferID); ok && item.item.OfferID != "" {
+			required = append(required, offer.Configurations...)
+		}
+		writeJSON(w, http.StatusOK, required)
+	})
+
+	s.handleDomainProducts("domain")
+	s.handleDomainProducts("domainTransfer")
+
+	s.handle("GET /order/cart/{cartID}/domainRestore", func(w http.ResponseWriter, r *http.Request) {
+		if s.cartOr404(w, r) != nil {
+			writeJSON(w, http.StatusOK, []ovh.OrderCartGenericProductDefinition{})
+		}
+	})
+
+	s.handle("GET /order/cart/{cartID}/domainPacks", func(w http.ResponseWriter, r *http.Request) {
+		if s.cartOr404(w, r) != nil {
+			writeJSON(w, http.StatusOK, []ovh.OrderCartDomainPacksProductInformation{})
+		}
+	})
+
+	s.handle("POST /order/cart/{cartID}/domainPacks", func(w http.ResponseWriter, r *http.Request) {
+		c := s.writableCartOr404(w, r)
+		if c == nil {
+			return
+		}
+		req := ovh.OrderPostDomainPacksReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.PlanCode == "" {
+			writeError(w, http.StatusBadRequest, "Missing parameter: planCode")
+			return
+		}
+		item := s.addItem(c, ovh.OrderCartItem{
+			ProductID: "domainPacks",
+			OfferID:   req.PlanCode,
+			Duration:  req.Duration,
+			Settings:  ovh.OrderCartDomainSettings{Domain: req.Domain, Quantity: req.Quantity},
+		}, req.Quantity)
+		writeJSON(w, http.StatusOK, item.item)
+	})
+}
@@ -0,0 +1,89 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// defaultQuota returns the limits of regions without quota set
+func defaultQuota(region string) ovh.Quota {
+	return ovh.Quota{
+		Region:   region,
+		Instance: &ovh.InstanceQuota{MaxInstances: 20, MaxCores: 20, MaxRAM: 40960},
+		Volume:   &ovh.VolumeQuota{MaxVolumeCount: 100, MaxGigabytes: 10000},
+		Keypair:  &ovh.KeypairQuota{MaxCount: 100},
+	}
+}
+
+// quota returns the limits of a region with their usage
+func (p *cloudProject) quota(region string) ovh.Quota {
+	quota := defaultQuota(region)
+	if q, ok := p.quotas[region]; ok {
+		if q.Instance != nil {
+			quota.Instance = &ovh.InstanceQuota{MaxInstances: q.Instance.MaxInstances, MaxCores: q.Instance.MaxCores, MaxRAM: q.Instance.MaxRAM}
+		}
+		if q.Volume != nil {
+			quota.Volume = &ovh.VolumeQuota{MaxVolumeCount: q.Volume.MaxVolumeCount, MaxGigabytes: q.Volume.MaxGigabytes}
+		}
+		if q.Keypair != nil {
+			quota.Keypair = &ovh.KeypairQuota{MaxCount: q.Keypair.MaxCount}
+		}
+	}
+	for _, instance := range p.instances {
+		if instance.Region != region {
+			continue
+		}
+		quota.Instance.UsedInstances++
+		if instance.Flavor != nil {
+			quota.Instance.UsedCores += instance.Flavor.Vcpus
+			quota.Instance.UsedRAM += instance.Flavor.MemoryGB * 1024
+		}
+	}
+	for _, volume := range p.volumes {
+		if volume.Region == region {
+			quota.Volume.VolumeCount++
+			quota.Volume.UsedGigabytes += volume.Size
+		}
+	}
+	return quota
+}
+
+// checkInstanceQuota answers a 403 if one more instance of flavor exceeds the
+// quota set for region
+func checkInstanceQuota(w http.ResponseWriter, p *cloudProject, region string, flavor *ovh.Flavor) bool {
+	if _, ok := p.quotas[region]; !ok {
+		return true
+	}
+	quota := p.quota(region)
+	if err := quota.CheckInstances(*flavor, 1); err != nil {
+		writeJSON(w, http.StatusForbidden, map[string]string{
+			"class":   "Client::Forbidden::QuotaExceeded",
+			"message": fmt.Sprintf("Quota exceeded for instances in region %s", region),
+		})
+		return false
+	}
+	return true
+}
+
+func (s *Server) registerCloudQuota() {
+	s.handle("GET /cloud/project/{projectID}/quota", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		regions := map[string]bool{}
+		for region := range p.regions {
+			regions[region] = true
+		}
+		for region := range p.quotas {
+			regions[region] = true
+		}
+		quotas := []ovh.Quota{}
+		for _, region := range sortedKeys(regions) {
+			quotas = append(quotas, p.quota(region))
+		}
+		writeJSON(w, http.StatusOK, quotas)
+	})
+}
@@ -0,0 +1,339 @@
+// Package ovhtest provides an in-process fake of the OVH API, to test code
+// built on the ovh SDK without network access nor OVH account.
+//
+// The fake checks request signatures like the real API, and keeps a state
+// for cloud projects, domains, vRacks, containers services, queues,
+// telephony, order carts and catalogs. The OpenStack APIs the SDK uses beside the
+// OVH API are served from OpenStackAuthURL. Populate it with the Add* and Set* methods:
+//
+//	server := ovhtest.NewServer()
+//	defer server.Close()
+//
+//	server.AddDomain(ovh.Domain{Domain: "example.com"})
+//
+//	client, err := server.Client()
+//	domains, err := client.DomainList(true)
+package ovhtest
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// Credentials accepted by a Server
+const (
+	AppKey      = "ovhtest-application-key"
+	AppSecret   = "ovhtest-application-secret"
+	ConsumerKey = "ovhtest-consumer-key"
+)
+
+// MaxTimeDrift is the maximum accepted difference between the timestamp of a
+// signed request and the server time
+const MaxTimeDrift = 60 * time.Second
+
+// Server is a fake OVH API, listening on a local address
+type Server struct {
+	*httptest.Server
+
+	routes []route
+
+	mutex   sync.Mutex
+	counter int
+	queries int
+
+	projects map[string]*cloudProject
+	domains  map[string]*ovh.Domain
+	vracks   map[string]*ovh.Vrack
+	// vrackProjects are the cloud projects of vRacks
+	vrackProjects map[string][]string
+	containers    map[string]*ovh.ContainersService
+	queues        map[string]*queueApp
+	telephony     map[string]*billingAccount
+	carts         map[string]*cart
+	cartOffers    []ovh.OrderCartProductInformation
+	cartOptions   []ovh.OrderCartGenericOptionDefinition
+	// catalogs are the public cloud catalogs, by subsidiary
+	catalogs map[string]*ovh.Catalog
+	// openStackTokens are the cloud projects of the Keystone tokens
+	openStackTokens map[string]string
+}
+
+// NewServer starts a fake OVH API. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		projects:      map[string]*cloudProject{},
+		domains:       map[string]*ovh.Domain{},
+		vracks:        map[string]*ovh.Vrack{},
+		vrackProjects: map[string][]string{},
+		containers:    map[string]*ovh.ContainersService{},
+		queues:        map[string]*queueApp{},
+		telephony:     map[string]*billingAccount{},
+		carts:         map[string]*cart{},
+		catalogs:      map[string]*ovh.Catalog{},
+
+		openStackTokens: map[string]string{},
+	}
+
+	s.route("GET /auth/time", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, time.Now().Unix())
+	})
+	s.route("POST /auth/credential", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"consumerKey":   ConsumerKey,
+			"state":         "pendingValidation",
+			"validationUrl": s.URL + "/auth/validate",
+		})
+	})
+
+	// instance groups first, as the first matching route is used and
+	// "group" also matches {instanceID}
+	s.registerCloudInstanceGroup()
+	s.registerCloud()
+	s.registerCloudInstance()
+	s.registerCloudSnapshot()
+	s.registerCloudVolume()
+	s.registerCloudNetwork()
+	s.registerCloudIP()
+	s.registerCloudQuota()
+	s.registerCloudUsage()
+	s.registerCloudUser()
+	s.registerCloudStorage()
+	s.registerDomain()
+	s.registerVrack()
+	s.registerCaas()
+	s.registerDBaasQueue()
+	s.registerTelephony()
+	s.registerOrderCart()
+	s.registerCatalog()
+	s.registerOpenStack()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a client for this server. Options are applied after the
+// server endpoint and credentials.
+func (s *Server) Client(opts ...ovh.Option) (*ovh.Client, error) {
+	return ovh.New(append([]ovh.Option{
+		ovh.WithEndpoint(s.URL),
+		ovh.WithKeys(AppKey, AppSecret, ConsumerKey),
+		ovh.WithRetries(0),
+	}, opts...)...)
+}
+
+// Queries returns the number of requests served, /auth/time excluded
+func (s *Server) Queries() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.queries
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	queryID := fmt.Sprintf("FR.ovhtest-%d", time.Now().UnixNano())
+	w.Header().Set("X-Ovh-QueryID", queryID)
+
+	// the OpenStack APIs authenticate with Keystone tokens instead
+	if strings.HasPrefix(r.URL.Path, openStackPrefix) {
+		s.serveOpenStack(w, r)
+		return
+	}
+
+	if r.Header.Get("X-Ovh-Application") != AppKey {
+		writeAuthError(w, http.StatusForbidden, "INVALID_KEY", "Invalid application key")
+		return
+	}
+
+	handler := s.match(r)
+	if handler == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Got an invalid (or empty) URL: %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	if r.URL.Path != "/auth/time" && r.URL.Path != "/auth/credential" {
+		if status, code, message := s.checkSignature(r); status != http.StatusOK {
+			writeAuthError(w, status, code, message)
+			return
+		}
+	}
+
+	s.mutex.Lock()
+	if r.URL.Path != "/auth/time" {
+		s.queries++
+	}
+	s.mutex.Unlock()
+
+	handler.ServeHTTP(w, r)
+}
+
+// checkSignature verifies the signature headers of r, and leaves its body
+// readable for the handler. It returns the status, error code and message of
+// the error answered to invalid requests.
+func (s *Server) checkSignature(r *http.Request) (int, string, string) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return http.StatusBadRequest, "", err.Error()
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if r.Header.Get("X-Ovh-Consumer") != ConsumerKey {
+		return http.StatusForbidden, "INVALID_CREDENTIAL", "This credential is not valid"
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, "", "Invalid timestamp"
+	}
+	if drift := time.Since(time.Unix(timestamp, 0)); drift > MaxTimeDrift || drift < -MaxTimeDrift {
+		return http.StatusBadRequest, "QUERY_TIME_OUT", "Query out of time"
+	}
+
+	h := sha1.New()
+	h.Write([]byte(fmt.Sprintf("%s+%s+%s+%s%s+%s+%d",
+		AppSecret,
+		ConsumerKey,
+		r.Method,
+		s.URL,
+		r.RequestURI,
+		body,
+		timestamp,
+	)))
+	if r.Header.Get("X-Ovh-Signature") != fmt.Sprintf("$1$%x", h.Sum(nil)) {
+		return http.StatusBadRequest, "INVALID_SIGNATURE", "Invalid signature"
+	}
+
+	return http.StatusOK, "", ""
+}
+
+// route is a handler for a method and a path pattern. Segments of the
+// pattern like {name} match any segment, available with r.PathValue(name).
+type route struct {
+	method   string
+	segments []string
+	handler  http.HandlerFunc
+}
+
+// route registers a handler for a pattern such as "GET /domain/{domain}"
+func (s *Server) route(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(path, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+// handle registers a handler running with the server state locked
+func (s *Server) handle(pattern string, handler http.HandlerFunc) {
+	s.route(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		handler(w, r)
+	})
+}
+
+// match returns the handler of the route matching r, nil if none does
+func (s *Server) match(r *http.Request) http.HandlerFunc {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+routes:
+	for _, route := range s.routes {
+		if route.method != r.Method || len(route.segments) != len(segments) {
+			continue
+		}
+		values := map[string]string{}
+		for i, segment := range route.segments {
+			switch {
+			case strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}"):
+				values[segment[1:len(segment)-1]] = segments[i]
+			case segment != segments[i]:
+				continue routes
+			}
+		}
+		for name, value := range values {
+			r.SetPathValue(name, value)
+		}
+		return route.handler
+	}
+	return nil
+}
+
+// nextID returns a new unique identifier, prefixed with prefix
+func (s *Server) nextID(prefix string) string {
+	s.counter++
+	return fmt.Sprintf("%s-%d", prefix, s.counter)
+}
+
+// nextIntID returns a new unique numeric identifier
+func (s *Server) nextIntID() int {
+	s.counter++
+	return s.counter
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError answers an error with its class, such as "Client::NotFound", like the API
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"class": errorClass(status), "message": message})
+}
+
+// writeAuthError answers an authentication error with its code, such as "INVALID_CREDENTIAL"
+func writeAuthError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{"class": errorClass(status), "errorCode": code, "message": message})
+}
+
+func errorClass(status int) string {
+	class := "Client::"
+	if status >= http.StatusInternalServerError {
+		class = "Server::"
+	}
+	return class + strings.ReplaceAll(http.StatusText(status), " ", "")
+}
+
+func writeNotFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("This %s does not exist: %s", kind, id))
+}
+
+// readJSON decodes the body of r into v, and answers a 400 on failure
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// sortedKeys returns the keys of a map with string keys, sorted
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedIntKeys returns the keys of a map with int keys, sorted
+func sortedIntKeys[K int | int64, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,60 @@
+package ovhtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+func TestServerChecksCredentials(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddVrack(ovh.Vrack{})
+
+	for _, test := range []struct {
+		name   string
+		keys   ovh.Option
+		status int
+	}{
+		{"valid", ovh.WithKeys(AppKey, AppSecret, ConsumerKey), http.StatusOK},
+		{"application key", ovh.WithKeys("other", AppSecret, ConsumerKey), http.StatusForbidden},
+		{"consumer key", ovh.WithKeys(AppKey, AppSecret, "other"), http.StatusForbidden},
+		{"signature", ovh.WithKeys(AppKey, "other", ConsumerKey), http.StatusBadRequest},
+	} {
+		client, err := server.Client(test.keys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.VrackList()
+		if test.status == http.StatusOK {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		var apiErr *govh.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != test.status || apiErr.QueryID == "" {
+			t.Errorf("%s: expected a %d error with a query ID, got %v", test.name, test.status, err)
+		}
+	}
+}
+
+func TestServerUnknownRoute(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []string{"GET", "PATCH"} {
+		err = client.CallAPICtx(context.Background(), method, "/me", nil, nil, true)
+		if apiErr := (*govh.APIError)(nil); !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+			t.Errorf("%s: expected a 404 error, got %v", method, err)
+		}
+	}
+}
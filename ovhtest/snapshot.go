@@ -0,0 +1,83 @@
+package ovhtest
+
+import (
+	"net/http"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// snapshotOr404 returns the project and the snapshot of the request, or answers a 404
+func (s *Server) snapshotOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.Image) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	snapshot, ok := p.snapshots[r.PathValue("snapshotID")]
+	if !ok {
+		writeNotFound(w, "snapshot", r.PathValue("snapshotID"))
+		return nil, nil
+	}
+	return p, snapshot
+}
+
+func (s *Server) registerCloudSnapshot() {
+	s.handle("POST /cloud/project/{projectID}/instance/{instanceID}/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		p, instance := s.instanceOr404(w, r)
+		if instance == nil {
+			return
+		}
+		req := ovh.SnapshotReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.SnapshotName == "" {
+			writeError(w, http.StatusBadRequest, "Missing snapshotName")
+			return
+		}
+		if !checkInstanceStatus(w, instance, "snapshot", "ACTIVE", "SHUTOFF") {
+			return
+		}
+		snapshot := &ovh.Image{
+			ID:           s.nextID("snapshot"),
+			Name:         req.SnapshotName,
+			Region:       instance.Region,
+			Status:       "saving",
+			CreationDate: time.Now().UTC().Format(time.RFC3339),
+			Visibility:   "private",
+		}
+		if instance.Image != nil {
+			snapshot.OS = instance.Image.OS
+			snapshot.User = instance.Image.User
+		}
+		if instance.Flavor != nil {
+			snapshot.MinDisk = instance.Flavor.DiskSpaceGB
+		}
+		p.snapshots[snapshot.ID] = snapshot
+		// the snapshot is saved once read
+		p.nextStatus[snapshot.ID] = "active"
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/snapshot/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		p, snapshot := s.snapshotOr404(w, r)
+		if snapshot == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, snapshot)
+
+		if status, ok := p.nextStatus[snapshot.ID]; ok {
+			snapshot.Status = status
+			delete(p.nextStatus, snapshot.ID)
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/snapshot/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		p, snapshot := s.snapshotOr404(w, r)
+		if snapshot == nil {
+			return
+		}
+		delete(p.snapshots, snapshot.ID)
+		writeJSON(w, http.StatusOK, nil)
+	})
+}
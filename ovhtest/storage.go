@@ -0,0 +1,189 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// staticURL returns the URL a container is served at
+func staticURL(projectID string, container *ovh.Container) string {
+	return fmt.Sprintf("https://storage.%s.cloud.ovh.net/v1/AUTH_%s/%s", strings.ToLower(container.Region), projectID, container.Name)
+}
+
+// containerOr404 returns the project and the storage container of the request, or answers a 404
+func (s *Server) containerOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.Container) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	container, ok := p.containers[r.PathValue("containerID")]
+	if !ok {
+		writeNotFound(w, "container", r.PathValue("containerID"))
+		return nil, nil
+	}
+	return p, container
+}
+
+// s3CredentialsOr404 returns the project and the S3 credentials of the
+// request, or answers a 404
+func (s *Server) s3CredentialsOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.S3Credentials) {
+	p, user := s.userOr404(w, r)
+	if user == nil {
+		return nil, nil
+	}
+	creds, ok := p.s3Credentials[r.PathValue("access")]
+	if !ok || creds.UserID != strconv.Itoa(user.ID) {
+		writeNotFound(w, "S3 credentials", r.PathValue("access"))
+		return nil, nil
+	}
+	return p, creds
+}
+
+// removeS3Credentials removes the S3 credentials of a user of p
+func (p *cloudProject) removeS3Credentials(userID int) {
+	for access, creds := range p.s3Credentials {
+		if creds.UserID == strconv.Itoa(userID) {
+			delete(p.s3Credentials, access)
+		}
+	}
+}
+
+func (s *Server) registerCloudStorage() {
+	s.handle("GET /cloud/project/{projectID}/storage", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		containers := []*ovh.Container{}
+		for _, id := range sortedKeys(p.containers) {
+			containers = append(containers, p.containers[id])
+		}
+		writeJSON(w, http.StatusOK, containers)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/storage", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := ovh.ContainerCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.ContainerName == "" || req.Region == "" {
+			writeError(w, http.StatusBadRequest, "Missing containerName or region")
+			return
+		}
+		for _, container := range p.containers {
+			if container.Name == req.ContainerName && container.Region == req.Region {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Container %s already exists in region %s", req.ContainerName, req.Region))
+				return
+			}
+		}
+		container := &ovh.Container{
+			ID:            s.nextID("container"),
+			Name:          req.ContainerName,
+			Region:        req.Region,
+			Archive:       req.Archive,
+			ContainerType: ovh.ContainerPrivate,
+		}
+		container.StaticURL = staticURL(p.project.ID, container)
+		p.containers[container.ID] = container
+		writeJSON(w, http.StatusOK, container)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/storage/{containerID}", func(w http.ResponseWriter, r *http.Request) {
+		if _, container := s.containerOr404(w, r); container != nil {
+			// the details of a container do not include its ID
+			c := *container
+			c.ID = ""
+			writeJSON(w, http.StatusOK, c)
+		}
+	})
+
+	s.handle("PUT /cloud/project/{projectID}/storage/{containerID}", func(w http.ResponseWriter, r *http.Request) {
+		_, container := s.containerOr404(w, r)
+		if container == nil {
+			return
+		}
+		req := struct {
+			ContainerType string `json:"containerType"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		switch req.ContainerType {
+		case ovh.ContainerPrivate, ovh.ContainerPublic, ovh.ContainerStatic:
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid containerType: %s", req.ContainerType))
+			return
+		}
+		if req.ContainerType == ovh.ContainerStatic && container.Archive {
+			writeError(w, http.StatusBadRequest, "Archive containers cannot host static websites")
+			return
+		}
+		container.ContainerType = req.ContainerType
+		container.Public = req.ContainerType != ovh.ContainerPrivate
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/storage/{containerID}", func(w http.ResponseWriter, r *http.Request) {
+		p, container := s.containerOr404(w, r)
+		if container == nil {
+			return
+		}
+		if container.StoredObjects > 0 {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Container %s is not empty", container.Name))
+			return
+		}
+		delete(p.containers, container.ID)
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/user/{userID}/s3Credentials", func(w http.ResponseWriter, r *http.Request) {
+		p, user := s.userOr404(w, r)
+		if user == nil {
+			return
+		}
+		creds := []ovh.S3Credentials{}
+		for _, access := range sortedKeys(p.s3Credentials) {
+			if c := *p.s3Credentials[access]; c.UserID == strconv.Itoa(user.ID) {
+				c.Secret = ""
+				creds = append(creds, c)
+			}
+		}
+		writeJSON(w, http.StatusOK, creds)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user/{userID}/s3Credentials", func(w http.ResponseWriter, r *http.Request) {
+		p, user := s.userOr404(w, r)
+		if user == nil {
+			return
+		}
+		creds := &ovh.S3Credentials{
+			Access:   fmt.Sprintf("%032x", s.nextIntID()),
+			Secret:   fmt.Sprintf("%032x", s.nextIntID()),
+			TenantID: p.project.ID,
+			UserID:   strconv.Itoa(user.ID),
+		}
+		p.s3Credentials[creds.Access] = creds
+		writeJSON(w, http.StatusOK, creds)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user/{userID}/s3Credentials/{access}/secret", func(w http.ResponseWriter, r *http.Request) {
+		if _, creds := s.s3CredentialsOr404(w, r); creds != nil {
+			writeJSON(w, http.StatusOK, map[string]string{"secret": creds.Secret})
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/user/{userID}/s3Credentials/{access}", func(w http.ResponseWriter, r *http.Request) {
+		if p, creds := s.s3CredentialsOr404(w, r); creds != nil {
+			delete(p.s3Credentials, creds.Access)
+			writeJSON(w, http.StatusOK, nil)
+		}
+	})
+}
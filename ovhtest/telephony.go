@@ -0,0 +1,193 @@
+package ovhtest
+
+import (
+	"net/http"
+	"strconv"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// billingAccount is the state of a telephony billing account
+type billingAccount struct {
+	telephony   ovh.Telephony
+	easyHunting map[string]*easyHunting
+}
+
+// easyHunting is the state of an easy hunting service
+type easyHunting struct {
+	service ovh.TelephonyEasyHunting
+	hunting ovh.TelephonyOvhPabxHunting
+	agents  map[int64]*ovh.TelephonyOvhPabxHuntingAgent
+}
+
+// AddBillingAccount adds a telephony billing account. A name is generated if empty.
+func (s *Server) AddBillingAccount(telephony ovh.Telephony) ovh.Telephony {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if telephony.BillingAccount == "" {
+		telephony.BillingAccount = s.nextID("ovhtel")
+	}
+	if telephony.Status == "" {
+		telephony.Status = "enabled"
+	}
+	s.billingAccount(telephony.BillingAccount).telephony = telephony
+	return telephony
+}
+
+// AddEasyHunting adds an easy hunting service to a billing account. A
+// service name is generated if empty.
+func (s *Server) AddEasyHunting(account string, service ovh.TelephonyEasyHunting) ovh.TelephonyEasyHunting {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if service.ServiceName == "" {
+		service.ServiceName = s.nextID("0033")
+	}
+	s.easyHunting(account, service.ServiceName).service = service
+	return service
+}
+
+// SetHunting sets the hunting settings of an easy hunting service
+func (s *Server) SetHunting(account, serviceName string, hunting ovh.TelephonyOvhPabxHunting) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.easyHunting(account, serviceName).hunting = hunting
+}
+
+// AddHuntingAgent adds an agent to an easy hunting service. An ID is generated if empty.
+func (s *Server) AddHuntingAgent(account, serviceName string, agent ovh.TelephonyOvhPabxHuntingAgent) ovh.TelephonyOvhPabxHuntingAgent {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if agent.AgentID == 0 {
+		agent.AgentID = int64(s.nextIntID())
+	}
+	s.easyHunting(account, serviceName).agents[agent.AgentID] = &agent
+	return agent
+}
+
+// billingAccount returns the state of a billing account, created if needed
+func (s *Server) billingAccount(account string) *billingAccount {
+	b, ok := s.telephony[account]
+	if !ok {
+		b = &billingAccount{
+			telephony:   ovh.Telephony{BillingAccount: account, Status: "enabled"},
+			easyHunting: map[string]*easyHunting{},
+		}
+		s.telephony[account] = b
+	}
+	return b
+}
+
+// easyHunting returns the state of an easy hunting service, created if needed
+func (s *Server) easyHunting(account, serviceName string) *easyHunting {
+	b := s.billingAccount(account)
+	e, ok := b.easyHunting[serviceName]
+	if !ok {
+		e = &easyHunting{
+			service: ovh.TelephonyEasyHunting{ServiceName: serviceName},
+			agents:  map[int64]*ovh.TelephonyOvhPabxHuntingAgent{},
+		}
+		b.easyHunting[serviceName] = e
+	}
+	return e
+}
+
+// easyHuntingOr404 returns the easy hunting service of the request, or answers a 404
+func (s *Server) easyHuntingOr404(w http.ResponseWriter, r *http.Request) *easyHunting {
+	b, ok := s.telephony[r.PathValue("billingAccount")]
+	if !ok {
+		writeNotFound(w, "billing account", r.PathValue("billingAccount"))
+		return nil
+	}
+	e, ok := b.easyHunting[r.PathValue("serviceName")]
+	if !ok {
+		writeNotFound(w, "easy hunting", r.PathValue("serviceName"))
+		return nil
+	}
+	return e
+}
+
+// agentOr404 returns the hunting agent of the request, or answers a 404
+func (s *Server) agentOr404(w http.ResponseWriter, r *http.Request) *ovh.TelephonyOvhPabxHuntingAgent {
+	e := s.easyHuntingOr404(w, r)
+	if e == nil {
+		return nil
+	}
+	agentID, err := strconv.ParseInt(r.PathValue("agentID"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid agentId: "+r.PathValue("agentID"))
+		return nil
+	}
+	agent, ok := e.agents[agentID]
+	if !ok {
+		writeNotFound(w, "agent", r.PathValue("agentID"))
+		return nil
+	}
+	return agent
+}
+
+func (s *Server) registerTelephony() {
+	s.handle("GET /telephony", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.telephony))
+	})
+
+	s.handle("GET /telephony/{billingAccount}", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.telephony[r.PathValue("billingAccount")]
+		if !ok {
+			writeNotFound(w, "billing account", r.PathValue("billingAccount"))
+			return
+		}
+		writeJSON(w, http.StatusOK, b.telephony)
+	})
+
+	s.handle("GET /telephony/{billingAccount}/easyHunting", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.telephony[r.PathValue("billingAccount")]
+		if !ok {
+			writeNotFound(w, "billing account", r.PathValue("billingAccount"))
+			return
+		}
+		writeJSON(w, http.StatusOK, sortedKeys(b.easyHunting))
+	})
+
+	s.handle("GET /telephony/{billingAccount}/easyHunting/{serviceName}", func(w http.ResponseWriter, r *http.Request) {
+		if e := s.easyHuntingOr404(w, r); e != nil {
+			writeJSON(w, http.StatusOK, e.service)
+		}
+	})
+
+	s.handle("GET /telephony/{billingAccount}/easyHunting/{serviceName}/hunting", func(w http.ResponseWriter, r *http.Request) {
+		if e := s.easyHuntingOr404(w, r); e != nil {
+			writeJSON(w, http.StatusOK, e.hunting)
+		}
+	})
+
+	s.handle("GET /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent", func(w http.ResponseWriter, r *http.Request) {
+		if e := s.easyHuntingOr404(w, r); e != nil {
+			writeJSON(w, http.StatusOK, sortedIntKeys(e.agents))
+		}
+	})
+
+	s.handle("GET /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent/{agentID}", func(w http.ResponseWriter, r *http.Request) {
+		if agent := s.agentOr404(w, r); agent != nil {
+			writeJSON(w, http.StatusOK, agent)
+		}
+	})
+
+	s.handle("PUT /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent/{agentID}", func(w http.ResponseWriter, r *http.Request) {
+		agent := s.agentOr404(w, r)
+		if agent == nil {
+			return
+		}
+		update := *agent
+		if !readJSON(w, r, &update) {
+			return
+		}
+		// the identifier cannot be changed
+		update.AgentID = agent.AgentID
+		*agent = update
+		writeJSON(w, http.StatusOK, nil)
+	})
+}
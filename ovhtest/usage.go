@@ -0,0 +1,59 @@
+package ovhtest
+
+import (
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// day returns the day of an RFC 3339 date, such as 2006-01-02, compared as a string
+func day(date string) string {
+	if len(date) > len("2006-01-02") {
+		return date[:len("2006-01-02")]
+	}
+	return date
+}
+
+func (s *Server) registerCloudUsage() {
+	s.handle("GET /cloud/project/{projectID}/usage/current", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, p.usage)
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/usage/forecast", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, p.forecast)
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/usage/history", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+		usages := []ovh.Usage{}
+		for _, id := range sortedKeys(p.usageHistory) {
+			usage := p.usageHistory[id]
+			if (from != "" && day(usage.Period.To) < from) || (to != "" && day(usage.Period.From) > to) {
+				continue
+			}
+			usages = append(usages, ovh.Usage{ID: usage.ID, Period: usage.Period, LastUpdate: usage.LastUpdate})
+		}
+		writeJSON(w, http.StatusOK, usages)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/usage/history/{usageID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		usage, ok := p.usageHistory[r.PathValue("usageID")]
+		if !ok {
+			writeNotFound(w, "usage", r.PathValue("usageID"))
+			return
+		}
+		writeJSON(w, http.StatusOK, usage)
+	})
+}
@@ -0,0 +1,162 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// roles are the roles which can be given to the users of every project
+var roles = []ovh.Role{
+	{ID: "role-administrator", Name: "administrator", Description: "Administrator", Permissions: []string{"*"}},
+	{ID: "role-compute-operator", Name: "compute_operator", Description: "Compute Operator", Permissions: []string{"compute:*"}},
+	{ID: "role-image-operator", Name: "image_operator", Description: "Image Operator", Permissions: []string{"image:*"}},
+	{ID: "role-network-operator", Name: "network_operator", Description: "Network Operator", Permissions: []string{"network:*"}},
+	{ID: "role-objectstore-operator", Name: "objectstore_operator", Description: "ObjectStore Operator", Permissions: []string{"objectstore:*"}},
+	{ID: "role-volume-operator", Name: "volume_operator", Description: "Volume Operator", Permissions: []string{"volume:*"}},
+}
+
+// userStatus returns a user of p, after applying its next status
+func (p *cloudProject) userStatus(userID int) *ovh.User {
+	user := p.users[userID]
+	if status, ok := p.nextStatus[strconv.Itoa(userID)]; ok {
+		user.Status = status
+		delete(p.nextStatus, strconv.Itoa(userID))
+	}
+	return user
+}
+
+// userOr404 returns the project and the user of the request, or answers a 404
+func (s *Server) userOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.User) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	userID, err := strconv.Atoi(r.PathValue("userID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid userId: "+r.PathValue("userID"))
+		return nil, nil
+	}
+	if _, ok := p.users[userID]; !ok {
+		writeNotFound(w, "user", r.PathValue("userID"))
+		return nil, nil
+	}
+	return p, p.userStatus(userID)
+}
+
+func (s *Server) registerCloudUser() {
+	s.handle("GET /cloud/project/{projectID}/role", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"roles": roles})
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/user/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		if _, user := s.userOr404(w, r); user != nil {
+			u := *user
+			u.Password = ""
+			writeJSON(w, http.StatusOK, u)
+		}
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/user/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		if p, user := s.userOr404(w, r); user != nil {
+			delete(p.users, user.ID)
+			p.removeS3Credentials(user.ID)
+			writeJSON(w, http.StatusOK, nil)
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user/{userID}/regeneratePassword", func(w http.ResponseWriter, r *http.Request) {
+		if _, user := s.userOr404(w, r); user != nil {
+			user.Password = fmt.Sprintf("password-%d-%d", user.ID, s.nextIntID())
+			writeJSON(w, http.StatusOK, user)
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user/{userID}/token", func(w http.ResponseWriter, r *http.Request) {
+		p, user := s.userOr404(w, r)
+		if user == nil {
+			return
+		}
+		req := struct {
+			Password string `json:"password"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Password != user.Password {
+			writeError(w, http.StatusBadRequest, "Invalid password")
+			return
+		}
+		token := s.nextID("token")
+		s.openStackTokens[token] = p.project.ID
+		writeJSON(w, http.StatusOK, ovh.UserToken{
+			AuthToken: token,
+			Token: ovh.UserTokenDetails{
+				IssuedAt:  "2024-01-01T00:00:00Z",
+				ExpiresAt: "2024-01-02T00:00:00Z",
+			},
+		})
+	})
+
+	s.handle("GET /cloud/project/{projectID}/user/{userID}/role", func(w http.ResponseWriter, r *http.Request) {
+		if _, user := s.userOr404(w, r); user != nil {
+			userRoles := user.Roles
+			if userRoles == nil {
+				userRoles = []ovh.Role{}
+			}
+			writeJSON(w, http.StatusOK, userRoles)
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/user/{userID}/role", func(w http.ResponseWriter, r *http.Request) {
+		_, user := s.userOr404(w, r)
+		if user == nil {
+			return
+		}
+		req := struct {
+			RoleID string `json:"roleId"`
+		}{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		var role *ovh.Role
+		for i := range roles {
+			if roles[i].ID == req.RoleID {
+				role = &roles[i]
+			}
+		}
+		if role == nil {
+			writeError(w, http.StatusBadRequest, "Invalid roleId: "+req.RoleID)
+			return
+		}
+		has := false
+		for _, userRole := range user.Roles {
+			has = has || userRole.ID == role.ID
+		}
+		if !has {
+			user.Roles = append(user.Roles, *role)
+		}
+		u := *user
+		u.Password = ""
+		writeJSON(w, http.StatusOK, u)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/user/{userID}/role/{roleID}", func(w http.ResponseWriter, r *http.Request) {
+		_, user := s.userOr404(w, r)
+		if user == nil {
+			return
+		}
+		for i, role := range user.Roles {
+			if role.ID == r.PathValue("roleID") {
+				user.Roles = append(user.Roles[:i:i], user.Roles[i+1:]...)
+				writeJSON(w, http.StatusOK, nil)
+				return
+			}
+		}
+		writeNotFound(w, "role", r.PathValue("roleID"))
+	})
+}
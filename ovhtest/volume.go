@@ -0,0 +1,263 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// volumeOr404 returns the project and the volume of the request, or answers a 404
+func (s *Server) volumeOr404(w http.ResponseWriter, r *http.Request) (*cloudProject, *ovh.Volume) {
+	p := s.projectOr404(w, r)
+	if p == nil {
+		return nil, nil
+	}
+	volume, ok := p.volumes[r.PathValue("volumeID")]
+	if !ok {
+		writeNotFound(w, "volume", r.PathValue("volumeID"))
+		return nil, nil
+	}
+	return p, volume
+}
+
+// checkVolumeStatus answers a 409 if the status of volume is not one of statuses
+func checkVolumeStatus(w http.ResponseWriter, volume *ovh.Volume, action string, statuses ...string) bool {
+	for _, status := range statuses {
+		if volume.Status == status {
+			return true
+		}
+	}
+	writeError(w, http.StatusConflict, fmt.Sprintf("Cannot '%s' volume %s while it is in status %s", action, volume.ID, volume.Status))
+	return false
+}
+
+func (s *Server) registerCloudVolume() {
+	// volume snapshots first, as the first matching route is used and
+	// "snapshot" also matches {volumeID}
+	s.handle("GET /cloud/project/{projectID}/volume/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.volumeSnapshots, r, func(v *ovh.VolumeSnapshot) string { return v.Region }))
+		}
+	})
+
+	s.handle("GET /cloud/project/{projectID}/volume/snapshot/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		snapshot, ok := p.volumeSnapshots[r.PathValue("snapshotID")]
+		if !ok {
+			writeNotFound(w, "volume snapshot", r.PathValue("snapshotID"))
+			return
+		}
+		writeJSON(w, http.StatusOK, snapshot)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/volume/snapshot/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		if _, ok := p.volumeSnapshots[r.PathValue("snapshotID")]; !ok {
+			writeNotFound(w, "volume snapshot", r.PathValue("snapshotID"))
+			return
+		}
+		delete(p.volumeSnapshots, r.PathValue("snapshotID"))
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/volume", func(w http.ResponseWriter, r *http.Request) {
+		if p := s.projectOr404(w, r); p != nil {
+			writeJSON(w, http.StatusOK, inRegion(p.volumes, r, func(v *ovh.Volume) string { return v.Region }))
+		}
+	})
+
+	s.handle("POST /cloud/project/{projectID}/volume", func(w http.ResponseWriter, r *http.Request) {
+		p := s.projectOr404(w, r)
+		if p == nil {
+			return
+		}
+		req := ovh.VolumeCreateOpts{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Region == "" || req.Size < 10 {
+			writeError(w, http.StatusBadRequest, "Missing region, or size under 10 GB")
+			return
+		}
+		if req.Type == "" {
+			req.Type = ovh.VolumeClassic
+		}
+		if req.Type != ovh.VolumeClassic && req.Type != ovh.VolumeHighSpeed {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid volume type: %s", req.Type))
+			return
+		}
+		if req.ImageID != "" && imageOr404(w, p, req.ImageID) == nil {
+			return
+		}
+		if req.SnapshotID != "" {
+			snapshot, ok := p.volumeSnapshots[req.SnapshotID]
+			if !ok {
+				writeNotFound(w, "volume snapshot", req.SnapshotID)
+				return
+			}
+			if req.Size < snapshot.Size {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Volume smaller than snapshot %s", snapshot.ID))
+				return
+			}
+		}
+		volume := &ovh.Volume{
+			ID:           s.nextID("volume"),
+			Name:         req.Name,
+			Description:  req.Description,
+			Region:       req.Region,
+			Size:         req.Size,
+			Type:         req.Type,
+			Status:       "creating",
+			Bootable:     req.ImageID != "",
+			AttachedTo:   []string{},
+			CreationDate: time.Now().UTC().Format(time.RFC3339),
+		}
+		p.volumes[volume.ID] = volume
+		// the volume is created once read
+		p.nextStatus[volume.ID] = "available"
+		writeJSON(w, http.StatusOK, volume)
+	})
+
+	s.handle("GET /cloud/project/{projectID}/volume/{volumeID}", func(w http.ResponseWriter, r *http.Request) {
+		p, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, volume)
+
+		if status, ok := p.nextStatus[volume.ID]; ok {
+			volume.Status = status
+			delete(p.nextStatus, volume.ID)
+		}
+	})
+
+	s.handle("PUT /cloud/project/{projectID}/volume/{volumeID}", func(w http.ResponseWriter, r *http.Request) {
+		_, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		req := ovh.VolumeUpdateReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Name != "" {
+			volume.Name = req.Name
+		}
+		if req.Description != "" {
+			volume.Description = req.Description
+		}
+		writeJSON(w, http.StatusOK, volume)
+	})
+
+	s.handle("DELETE /cloud/project/{projectID}/volume/{volumeID}", func(w http.ResponseWriter, r *http.Request) {
+		p, volume := s.volumeOr404(w, r)
+		if volume == nil || !checkVolumeStatus(w, volume, "delete", "available", "error") {
+			return
+		}
+		delete(p.volumes, volume.ID)
+		writeJSON(w, http.StatusOK, nil)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/volume/{volumeID}/attach", func(w http.ResponseWriter, r *http.Request) {
+		p, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		req := ovh.VolumeAttachReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		instance, ok := p.instances[req.InstanceID]
+		if !ok {
+			writeNotFound(w, "instance", req.InstanceID)
+			return
+		}
+		if instance.Region != volume.Region {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Volume %s and instance %s are in different regions", volume.ID, instance.ID))
+			return
+		}
+		if !checkVolumeStatus(w, volume, "attach", "available") {
+			return
+		}
+		volume.Status = "attaching"
+		volume.AttachedTo = []string{instance.ID}
+		p.nextStatus[volume.ID] = "in-use"
+		writeJSON(w, http.StatusOK, volume)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/volume/{volumeID}/detach", func(w http.ResponseWriter, r *http.Request) {
+		p, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		req := ovh.VolumeAttachReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if len(volume.AttachedTo) == 0 || volume.AttachedTo[0] != req.InstanceID {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Volume %s is not attached to instance %s", volume.ID, req.InstanceID))
+			return
+		}
+		if !checkVolumeStatus(w, volume, "detach", "in-use") {
+			return
+		}
+		volume.Status = "detaching"
+		volume.AttachedTo = []string{}
+		p.nextStatus[volume.ID] = "available"
+		writeJSON(w, http.StatusOK, volume)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/volume/{volumeID}/upsize", func(w http.ResponseWriter, r *http.Request) {
+		_, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		req := ovh.VolumeUpsizeReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if req.Size <= volume.Size {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Volume %s cannot shrink from %d GB to %d GB", volume.ID, volume.Size, req.Size))
+			return
+		}
+		if !checkVolumeStatus(w, volume, "upsize", "available", "in-use") {
+			return
+		}
+		volume.Size = req.Size
+		writeJSON(w, http.StatusOK, volume)
+	})
+
+	s.handle("POST /cloud/project/{projectID}/volume/{volumeID}/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		p, volume := s.volumeOr404(w, r)
+		if volume == nil {
+			return
+		}
+		req := ovh.VolumeSnapshotReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if !checkVolumeStatus(w, volume, "snapshot", "available", "in-use") {
+			return
+		}
+		snapshot := &ovh.VolumeSnapshot{
+			ID:           s.nextID("volume-snapshot"),
+			Name:         req.Name,
+			Description:  req.Description,
+			VolumeID:     volume.ID,
+			Region:       volume.Region,
+			Size:         volume.Size,
+			Status:       "available",
+			CreationDate: time.Now().UTC().Format(time.RFC3339),
+		}
+		p.volumeSnapshots[snapshot.ID] = snapshot
+		writeJSON(w, http.StatusOK, snapshot)
+	})
+}
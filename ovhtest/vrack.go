@@ -0,0 +1,70 @@
+package ovhtest
+
+import (
+	"fmt"
+	"net/http"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+// AddVrack adds a vRack. A name is generated if empty.
+func (s *Server) AddVrack(vrack ovh.Vrack) ovh.Vrack {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if vrack.Name == "" {
+		vrack.Name = s.nextID("pn")
+	}
+	s.vracks[vrack.Name] = &vrack
+	return vrack
+}
+
+func (s *Server) registerVrack() {
+	s.handle("GET /vrack", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, sortedKeys(s.vracks))
+	})
+
+	s.handle("GET /vrack/{vrack}", func(w http.ResponseWriter, r *http.Request) {
+		vrack, ok := s.vracks[r.PathValue("vrack")]
+		if !ok {
+			writeNotFound(w, "vRack", r.PathValue("vrack"))
+			return
+		}
+		writeJSON(w, http.StatusOK, vrack)
+	})
+
+	s.handle("GET /vrack/{vrack}/cloudProject", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.vracks[r.PathValue("vrack")]; !ok {
+			writeNotFound(w, "vRack", r.PathValue("vrack"))
+			return
+		}
+		projects := append([]string{}, s.vrackProjects[r.PathValue("vrack")]...)
+		writeJSON(w, http.StatusOK, projects)
+	})
+
+	s.handle("POST /vrack/{vrack}/cloudProject", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("vrack")
+		if _, ok := s.vracks[name]; !ok {
+			writeNotFound(w, "vRack", name)
+			return
+		}
+		req := ovh.VrackCloudProjectReq{}
+		if !readJSON(w, r, &req) {
+			return
+		}
+		if _, ok := s.projects[req.Project]; !ok {
+			writeNotFound(w, "project", req.Project)
+			return
+		}
+		for vrack, projects := range s.vrackProjects {
+			for _, project := range projects {
+				if project == req.Project {
+					writeError(w, http.StatusConflict, fmt.Sprintf("Project %s is already in vRack %s", project, vrack))
+					return
+				}
+			}
+		}
+		s.vrackProjects[name] = append(s.vrackProjects[name], req.Project)
+		writeJSON(w, http.StatusOK, ovh.VrackTask{ID: s.nextIntID(), Function: "addCloudProjectToVrack", Status: "init", ServiceName: name, TargetDomain: req.Project})
+	})
+}
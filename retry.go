@@ -0,0 +1,119 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+// Default retry settings of clients built with New
+const (
+	DefaultRetries      = 3
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// retryMinWait is the wait before the first retry, doubled on each attempt
+const retryMinWait = 500 * time.Millisecond
+
+// WithRetries sets how many times a failed request is retried, DefaultRetries
+// by default. 0 disables retries.
+//
+// Network errors, rate limits (429) and server errors (500, 502, 503, 504)
+// are retried with an exponential backoff plus jitter, or after the delay
+// asked by the API in a Retry-After header. GET, PUT and DELETE requests are
+// retried, POST requests only when their context is built with RetryPost.
+func WithRetries(retries int) Option {
+	return func(o *clientOptions) {
+		o.retries = retries
+	}
+}
+
+// WithRetryMaxWait caps the wait between two attempts, DefaultRetryMaxWait by default
+func WithRetryMaxWait(maxWait time.Duration) Option {
+	return func(o *clientOptions) {
+		o.retryMaxWait = maxWait
+	}
+}
+
+type retryPostKey struct{}
+
+// RetryPost returns a context allowing POST requests made with it to be
+// retried. POST requests are not idempotent: only opt in when running the
+// same request twice is harmless.
+func RetryPost(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryPostKey{}, true)
+}
+
+// isRetryable tells if a request that failed with err may be run again
+func isRetryable(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if method == "POST" {
+		if retry, _ := ctx.Value(retryPostKey{}).(bool); !retry {
+			return false
+		}
+	}
+
+	var apiErr *govh.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var urlErr *url.Error
+	// the request did not go through
+	return errors.As(err, &urlErr) && !errors.Is(err, ErrNotRecorded)
+}
+
+// retryWait returns how long to wait before the next attempt
+func (c *Client) retryWait(attempt int, retryAfter time.Duration) time.Duration {
+	maxWait := c.retryMaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if retryAfter > 0 {
+		if retryAfter > maxWait {
+			return maxWait
+		}
+		return retryAfter
+	}
+
+	wait := maxWait
+	if attempt < 16 && retryMinWait<<uint(attempt) < maxWait {
+		wait = retryMinWait << uint(attempt)
+	}
+
+	// full jitter on the upper half, so that concurrent clients spread out
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter reads a Retry-After header, either a number of seconds or
+// an HTTP date. It returns 0 when the header is missing or invalid.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
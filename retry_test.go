@@ -0,0 +1,115 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	govh "github.com/ovh/go-ovh/ovh"
+)
+
+// newFlakyServer fails the first failures calls to /vrack with status
+func newFlakyServer(failures int32, status int, retryAfter string) (*httptest.Server, *int32) {
+	calls := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/time" {
+			json.NewEncoder(w).Encode(time.Now().Unix())
+			return
+		}
+		if atomic.AddInt32(calls, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(map[string]string{"message": "try again"})
+			return
+		}
+		json.NewEncoder(w).Encode([]string{"pn-1"})
+	}))
+	return server, calls
+}
+
+func newRetryClient(t *testing.T, endpoint string, retries int) *Client {
+	c, err := New(WithEndpoint(endpoint), WithKeys("ak", "as", "ck"), WithRetries(retries), WithRetryMaxWait(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	server, calls := newFlakyServer(2, http.StatusServiceUnavailable, "")
+	defer server.Close()
+
+	vracks, err := newRetryClient(t, server.URL, 3).VrackList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vracks) != 1 || *calls != 3 {
+		t.Fatalf("expected 1 vrack after 3 calls, got %d vracks after %d calls", len(vracks), *calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	server, calls := newFlakyServer(10, http.StatusTooManyRequests, "1")
+	defer server.Close()
+
+	_, err := newRetryClient(t, server.URL, 2).VrackList()
+	if apiErr := (*govh.APIError)(nil); !errors.As(err, &apiErr) || apiErr.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 error, got %v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestRetryClientErrorsAreNotRetried(t *testing.T) {
+	server, calls := newFlakyServer(10, http.StatusNotFound, "")
+	defer server.Close()
+
+	if _, err := newRetryClient(t, server.URL, 3).VrackList(); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestRetryPost(t *testing.T) {
+	server, calls := newFlakyServer(1, http.StatusBadGateway, "")
+	defer server.Close()
+	c := newRetryClient(t, server.URL, 3)
+
+	if err := c.post(context.Background(), "/vrack", nil, nil); err == nil {
+		t.Fatal("POST must not be retried by default")
+	}
+
+	atomic.StoreInt32(calls, 0)
+	if err := c.post(RetryPost(context.Background()), "/vrack", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &Client{retryMaxWait: 4 * time.Second}
+
+	for attempt := 0; attempt < 20; attempt++ {
+		if wait := c.retryWait(attempt, 0); wait > 4*time.Second || wait <= 0 {
+			t.Fatalf("unexpected wait %s for attempt %d", wait, attempt)
+		}
+	}
+	if wait := c.retryWait(0, 2*time.Second); wait != 2*time.Second {
+		t.Fatalf("expected Retry-After to be used, got %s", wait)
+	}
+	if wait := c.retryWait(0, time.Minute); wait != 4*time.Second {
+		t.Fatalf("expected Retry-After to be capped, got %s", wait)
+	}
+}
@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -29,8 +30,13 @@ type Telephony struct {
 
 // TelephonyListBillingAccount list all your telephony services
 func (c *Client) TelephonyListBillingAccount(withDetails bool) ([]Telephony, error) {
+	return c.TelephonyListBillingAccountCtx(context.Background(), withDetails)
+}
+
+// TelephonyListBillingAccountCtx is TelephonyListBillingAccount with a context
+func (c *Client) TelephonyListBillingAccountCtx(ctx context.Context, withDetails bool) ([]Telephony, error) {
 	var names []string
-	if err := c.OVHClient.Get("/telephony", &names); err != nil {
+	if err := c.get(ctx, "/telephony", &names); err != nil {
 		return nil, err
 	}
 
@@ -43,35 +49,19 @@ func (c *Client) TelephonyListBillingAccount(withDetails bool) ([]Telephony, err
 		return services, nil
 	}
 
-	servicesChan, errChan := make(chan Telephony), make(chan error)
-	for _, telephony := range services {
-		go func(telephony Telephony) {
-			d, err := c.TelephonyBillingAccountInfo(telephony.BillingAccount)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			servicesChan <- *d
-		}(telephony)
-	}
-
-	servicesComplete := []Telephony{}
-
-	for i := 0; i < len(services); i++ {
-		select {
-		case services := <-servicesChan:
-			servicesComplete = append(servicesComplete, services)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return servicesComplete, nil
+	return FetchDetails(ctx, c, services, func(ctx context.Context, telephony Telephony) (*Telephony, error) {
+		return c.TelephonyBillingAccountInfoCtx(ctx, telephony.BillingAccount)
+	})
 }
 
 // TelephonyBillingAccountInfo retrieve all infos of one of your services
 func (c *Client) TelephonyBillingAccountInfo(billingAccount string) (*Telephony, error) {
+	return c.TelephonyBillingAccountInfoCtx(context.Background(), billingAccount)
+}
+
+// TelephonyBillingAccountInfoCtx is TelephonyBillingAccountInfo with a context
+func (c *Client) TelephonyBillingAccountInfoCtx(ctx context.Context, billingAccount string) (*Telephony, error) {
 	telephony := &Telephony{}
-	err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s", url.QueryEscape(billingAccount)), telephony)
+	err := c.get(ctx, fmt.Sprintf("/telephony/%s", url.QueryEscape(billingAccount)), telephony)
 	return telephony, err
 }
@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -78,8 +79,13 @@ type TelephonyOvhPabxHuntingAgent struct {
 // TelephonyEasyHuntingList list all OVH easy calls queues associated with this billing account
 // GET /telephony/{billingAccount}/easyHunting
 func (c *Client) TelephonyEasyHuntingList(billingAccount string, withDetails bool) ([]TelephonyEasyHunting, error) {
+	return c.TelephonyEasyHuntingListCtx(context.Background(), billingAccount, withDetails)
+}
+
+// TelephonyEasyHuntingListCtx is TelephonyEasyHuntingList with a context
+func (c *Client) TelephonyEasyHuntingListCtx(ctx context.Context, billingAccount string, withDetails bool) ([]TelephonyEasyHunting, error) {
 	var names []string
-	if err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s/easyHunting", url.QueryEscape(billingAccount)), &names); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/telephony/%s/easyHunting", url.QueryEscape(billingAccount)), &names); err != nil {
 		return nil, err
 	}
 
@@ -92,53 +98,47 @@ func (c *Client) TelephonyEasyHuntingList(billingAccount string, withDetails boo
 		return services, nil
 	}
 
-	servicesChan, errChan := make(chan TelephonyEasyHunting), make(chan error)
-	for _, telephonyEasyHunting := range services {
-		go func(billingAccount, serviceName string) {
-			d, err := c.TelephonyEasyHuntingInfo(billingAccount, serviceName)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			servicesChan <- *d
-		}(billingAccount, telephonyEasyHunting.ServiceName)
-	}
-
-	servicesComplete := []TelephonyEasyHunting{}
-
-	for i := 0; i < len(services); i++ {
-		select {
-		case services := <-servicesChan:
-			servicesComplete = append(servicesComplete, services)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return servicesComplete, nil
+	return FetchDetails(ctx, c, services, func(ctx context.Context, telephonyEasyHunting TelephonyEasyHunting) (*TelephonyEasyHunting, error) {
+		return c.TelephonyEasyHuntingInfoCtx(ctx, billingAccount, telephonyEasyHunting.ServiceName)
+	})
 }
 
 // TelephonyEasyHuntingInfo retrieve all infos of one easy hunting service
 // GET /telephony/{billingAccount}/easyHunting/{serviceName}
 func (c *Client) TelephonyEasyHuntingInfo(billingAccount, serviceName string) (*TelephonyEasyHunting, error) {
+	return c.TelephonyEasyHuntingInfoCtx(context.Background(), billingAccount, serviceName)
+}
+
+// TelephonyEasyHuntingInfoCtx is TelephonyEasyHuntingInfo with a context
+func (c *Client) TelephonyEasyHuntingInfoCtx(ctx context.Context, billingAccount, serviceName string) (*TelephonyEasyHunting, error) {
 	telephonyEasyHunting := &TelephonyEasyHunting{}
-	err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s/easyHunting/%s", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), telephonyEasyHunting)
+	err := c.get(ctx, fmt.Sprintf("/telephony/%s/easyHunting/%s", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), telephonyEasyHunting)
 	return telephonyEasyHunting, err
 }
 
 // TelephonyOvhPabxHunting retrieves info on OVH Pabx Hunting
 // GET /telephony/{billingAccount}/easyHunting/{serviceName}/hunting
 func (c *Client) TelephonyOvhPabxHunting(billingAccount, serviceName string) (*TelephonyOvhPabxHunting, error) {
+	return c.TelephonyOvhPabxHuntingCtx(context.Background(), billingAccount, serviceName)
+}
+
+// TelephonyOvhPabxHuntingCtx is TelephonyOvhPabxHunting with a context
+func (c *Client) TelephonyOvhPabxHuntingCtx(ctx context.Context, billingAccount, serviceName string) (*TelephonyOvhPabxHunting, error) {
 	telephonyOvhPabxHunting := &TelephonyOvhPabxHunting{}
-	err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), telephonyOvhPabxHunting)
+	err := c.get(ctx, fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), telephonyOvhPabxHunting)
 	return telephonyOvhPabxHunting, err
 }
 
 // TelephonyOvhPabxHuntingAgentList list all OVH easy calls queues associated with this billing account
 // GET  /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent
 func (c *Client) TelephonyOvhPabxHuntingAgentList(billingAccount, serviceName string, withDetails bool) ([]TelephonyOvhPabxHuntingAgent, error) {
+	return c.TelephonyOvhPabxHuntingAgentListCtx(context.Background(), billingAccount, serviceName, withDetails)
+}
+
+// TelephonyOvhPabxHuntingAgentListCtx is TelephonyOvhPabxHuntingAgentList with a context
+func (c *Client) TelephonyOvhPabxHuntingAgentListCtx(ctx context.Context, billingAccount, serviceName string, withDetails bool) ([]TelephonyOvhPabxHuntingAgent, error) {
 	var names []int64
-	if err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), &names); err != nil {
+	if err := c.get(ctx, fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent", url.QueryEscape(billingAccount), url.QueryEscape(serviceName)), &names); err != nil {
 		return nil, err
 	}
 
@@ -151,44 +151,33 @@ func (c *Client) TelephonyOvhPabxHuntingAgentList(billingAccount, serviceName st
 		return agents, nil
 	}
 
-	agentsChan, errChan := make(chan TelephonyOvhPabxHuntingAgent), make(chan error)
-	for _, agent := range agents {
-		go func(billingAccount, serviceName string, agentID int64) {
-			d, err := c.TelephonyOvhPabxHuntingAgentInfo(billingAccount, serviceName, agentID)
-			if err != nil {
-				errChan <- err
-				return
-			}
-			agentsChan <- *d
-		}(billingAccount, serviceName, agent.AgentID)
-	}
-
-	agentsComplete := []TelephonyOvhPabxHuntingAgent{}
-
-	for i := 0; i < len(agents); i++ {
-		select {
-		case agents := <-agentsChan:
-			agentsComplete = append(agentsComplete, agents)
-		case err := <-errChan:
-			return nil, err
-		}
-	}
-
-	return agentsComplete, nil
+	return FetchDetails(ctx, c, agents, func(ctx context.Context, agent TelephonyOvhPabxHuntingAgent) (*TelephonyOvhPabxHuntingAgent, error) {
+		return c.TelephonyOvhPabxHuntingAgentInfoCtx(ctx, billingAccount, serviceName, agent.AgentID)
+	})
 }
 
 // TelephonyOvhPabxHuntingAgentInfo gets info from OVH Pabx Hunting Agent
 // GET /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent
 func (c *Client) TelephonyOvhPabxHuntingAgentInfo(billingAccount, serviceName string, agentID int64) (*TelephonyOvhPabxHuntingAgent, error) {
+	return c.TelephonyOvhPabxHuntingAgentInfoCtx(context.Background(), billingAccount, serviceName, agentID)
+}
+
+// TelephonyOvhPabxHuntingAgentInfoCtx is TelephonyOvhPabxHuntingAgentInfo with a context
+func (c *Client) TelephonyOvhPabxHuntingAgentInfoCtx(ctx context.Context, billingAccount, serviceName string, agentID int64) (*TelephonyOvhPabxHuntingAgent, error) {
 	telephonyOvhPabxHuntingAgent := &TelephonyOvhPabxHuntingAgent{}
-	err := c.OVHClient.Get(fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent/%d", url.QueryEscape(billingAccount), url.QueryEscape(serviceName), agentID), telephonyOvhPabxHuntingAgent)
+	err := c.get(ctx, fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent/%d", url.QueryEscape(billingAccount), url.QueryEscape(serviceName), agentID), telephonyOvhPabxHuntingAgent)
 	return telephonyOvhPabxHuntingAgent, err
 }
 
 // TelephonyOvhPabxHuntingAgentUpdate update OVH Pabx Hunting Agent
 // PUT /telephony/{billingAccount}/easyHunting/{serviceName}/hunting/agent/{agentId}
 func (c *Client) TelephonyOvhPabxHuntingAgentUpdate(billingAccount, serviceName string, agentID int64, telephonyOvhPabxHuntingAgent TelephonyOvhPabxHuntingAgent) (*TelephonyOvhPabxHuntingAgent, error) {
+	return c.TelephonyOvhPabxHuntingAgentUpdateCtx(context.Background(), billingAccount, serviceName, agentID, telephonyOvhPabxHuntingAgent)
+}
+
+// TelephonyOvhPabxHuntingAgentUpdateCtx is TelephonyOvhPabxHuntingAgentUpdate with a context
+func (c *Client) TelephonyOvhPabxHuntingAgentUpdateCtx(ctx context.Context, billingAccount, serviceName string, agentID int64, telephonyOvhPabxHuntingAgent TelephonyOvhPabxHuntingAgent) (*TelephonyOvhPabxHuntingAgent, error) {
 	r := &TelephonyOvhPabxHuntingAgent{}
-	err := c.OVHClient.Put(fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent/%d", url.QueryEscape(billingAccount), url.QueryEscape(serviceName), agentID), telephonyOvhPabxHuntingAgent, r)
+	err := c.put(ctx, fmt.Sprintf("/telephony/%s/easyHunting/%s/hunting/agent/%d", url.QueryEscape(billingAccount), url.QueryEscape(serviceName), agentID), telephonyOvhPabxHuntingAgent, r)
 	return r, err
 }
@@ -0,0 +1,68 @@
+package ovh_test
+
+import (
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestTelephony(t *testing.T) {
+	server, client := newTestServer(t)
+	account := server.AddBillingAccount(ovh.Telephony{Description: "support"})
+	service := server.AddEasyHunting(account.BillingAccount, ovh.TelephonyEasyHunting{Description: "hotline", Strategy: "cumulativeTimeout"})
+	server.SetHunting(account.BillingAccount, service.ServiceName, ovh.TelephonyOvhPabxHunting{Name: "hotline", G729: true})
+	agent := server.AddHuntingAgent(account.BillingAccount, service.ServiceName, ovh.TelephonyOvhPabxHuntingAgent{Number: "0033100000001", Status: "available", Timeout: 20})
+
+	accounts, err := client.TelephonyListBillingAccount(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].Description != "support" {
+		t.Fatalf("unexpected billing accounts %+v", accounts)
+	}
+	if info, err := client.TelephonyBillingAccountInfo(account.BillingAccount); err != nil || info.Status != "enabled" {
+		t.Fatalf("unexpected billing account %+v, %v", info, err)
+	}
+
+	services, err := client.TelephonyEasyHuntingList(account.BillingAccount, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 1 || services[0].Strategy != "cumulativeTimeout" {
+		t.Fatalf("unexpected easy hunting services %+v", services)
+	}
+	if info, err := client.TelephonyEasyHuntingInfo(account.BillingAccount, service.ServiceName); err != nil || info.Description != "hotline" {
+		t.Fatalf("unexpected easy hunting service %+v, %v", info, err)
+	}
+
+	hunting, err := client.TelephonyOvhPabxHunting(account.BillingAccount, service.ServiceName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hunting.Name != "hotline" || !hunting.G729 {
+		t.Fatalf("unexpected hunting %+v", hunting)
+	}
+
+	agents, err := client.TelephonyOvhPabxHuntingAgentList(account.BillingAccount, service.ServiceName, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agents) != 1 || agents[0].AgentID != agent.AgentID || agents[0].Number != "0033100000001" {
+		t.Fatalf("unexpected agents %+v", agents)
+	}
+
+	update := ovh.TelephonyOvhPabxHuntingAgent{Status: "onBreak", Timeout: 20}
+	if _, err = client.TelephonyOvhPabxHuntingAgentUpdate(account.BillingAccount, service.ServiceName, agent.AgentID, update); err != nil {
+		t.Fatal(err)
+	}
+	info, err := client.TelephonyOvhPabxHuntingAgentInfo(account.BillingAccount, service.ServiceName, agent.AgentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Status != "onBreak" || info.AgentID != agent.AgentID {
+		t.Fatalf("expected the agent to be updated, got %+v", info)
+	}
+
+	_, err = client.TelephonyOvhPabxHuntingAgentInfo(account.BillingAccount, service.ServiceName, agent.AgentID+1)
+	checkNotFound(t, err)
+}
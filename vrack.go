@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -17,8 +18,13 @@ type Vrack struct {
 
 // VrackList ...
 func (c *Client) VrackList() ([]Vrack, error) {
+	return c.VrackListCtx(context.Background())
+}
+
+// VrackListCtx is VrackList with a context
+func (c *Client) VrackListCtx(ctx context.Context) ([]Vrack, error) {
 	ids := []string{}
-	e := c.OVHClient.Get("/vrack", &ids)
+	e := c.get(ctx, "/vrack", &ids)
 	vracks := []Vrack{}
 	for _, id := range ids {
 		vracks = append(vracks, Vrack{Name: id})
@@ -29,7 +35,52 @@ func (c *Client) VrackList() ([]Vrack, error) {
 
 // VrackInfo ...
 func (c *Client) VrackInfo(vrackName string) (*Vrack, error) {
+	return c.VrackInfoCtx(context.Background(), vrackName)
+}
+
+// VrackInfoCtx is VrackInfo with a context
+func (c *Client) VrackInfoCtx(ctx context.Context, vrackName string) (*Vrack, error) {
 	vrack := &Vrack{}
-	err := c.OVHClient.Get(fmt.Sprintf("/vrack/%s", url.QueryEscape(vrackName)), vrack)
+	err := c.get(ctx, fmt.Sprintf("/vrack/%s", url.QueryEscape(vrackName)), vrack)
 	return vrack, err
 }
+
+// VrackTask is a go representation of an asynchronous task of a vRack
+type VrackTask struct {
+	ID           int    `json:"id,omitempty"`
+	Function     string `json:"function,omitempty"`
+	Status       string `json:"status,omitempty"`
+	ServiceName  string `json:"serviceName,omitempty"`
+	TargetDomain string `json:"targetDomain,omitempty"`
+	TodoDate     string `json:"todoDate,omitempty"`
+}
+
+// VrackCloudProjectReq defines the fields to add a cloud project to a vRack
+type VrackCloudProjectReq struct {
+	Project string `json:"project"`
+}
+
+// VrackCloudProjects returns the IDs of the cloud projects of a vRack
+func (c *Client) VrackCloudProjects(vrackName string) ([]string, error) {
+	return c.VrackCloudProjectsCtx(context.Background(), vrackName)
+}
+
+// VrackCloudProjectsCtx is VrackCloudProjects with a context
+func (c *Client) VrackCloudProjectsCtx(ctx context.Context, vrackName string) ([]string, error) {
+	projects := []string{}
+	err := c.get(ctx, fmt.Sprintf("/vrack/%s/cloudProject", url.QueryEscape(vrackName)), &projects)
+	return projects, err
+}
+
+// VrackAddCloudProject adds a cloud project to a vRack, so that its private
+// networks use the vRack. It returns the task adding it.
+func (c *Client) VrackAddCloudProject(vrackName, projectID string) (*VrackTask, error) {
+	return c.VrackAddCloudProjectCtx(context.Background(), vrackName, projectID)
+}
+
+// VrackAddCloudProjectCtx is VrackAddCloudProject with a context
+func (c *Client) VrackAddCloudProjectCtx(ctx context.Context, vrackName, projectID string) (*VrackTask, error) {
+	task := &VrackTask{}
+	err := c.post(ctx, fmt.Sprintf("/vrack/%s/cloudProject", url.QueryEscape(vrackName)), VrackCloudProjectReq{Project: projectID}, task)
+	return task, err
+}
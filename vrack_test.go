@@ -0,0 +1,57 @@
+package ovh_test
+
+import (
+	"errors"
+	"testing"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestVracks(t *testing.T) {
+	server, client := newTestServer(t)
+	vrack := server.AddVrack(ovh.Vrack{Description: "backend"})
+
+	vracks, err := client.VrackList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vracks) != 1 || vracks[0].Name != vrack.Name {
+		t.Fatalf("unexpected vracks %+v", vracks)
+	}
+
+	info, err := client.VrackInfo(vrack.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Description != "backend" {
+		t.Fatalf("unexpected vrack %+v", info)
+	}
+
+	_, err = client.VrackInfo("pn-0")
+	checkNotFound(t, err)
+}
+
+func TestVrackCloudProjects(t *testing.T) {
+	server, client := newTestServer(t)
+	vrack := server.AddVrack(ovh.Vrack{Description: "backend"})
+	project := server.AddProject(ovh.Project{Name: "staging"})
+
+	task, err := client.VrackAddCloudProject(vrack.Name, project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.ID == 0 || task.TargetDomain != project.ID {
+		t.Fatalf("unexpected task %+v", task)
+	}
+	if _, err = client.VrackAddCloudProject(vrack.Name, project.ID); !errors.Is(err, ovh.ErrConflict) {
+		t.Fatalf("expected a conflict adding a project twice, got %v", err)
+	}
+
+	projects, err := client.VrackCloudProjects(vrack.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != 1 || projects[0] != project.ID {
+		t.Fatalf("unexpected projects %v", projects)
+	}
+}
@@ -0,0 +1,285 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultWaitInterval is the interval between two checks of a waiter
+const DefaultWaitInterval = 5 * time.Second
+
+// instanceRebootStartTimeout is how long WaitInstanceRebooted waits for a
+// reboot to start
+const instanceRebootStartTimeout = time.Minute
+
+// instanceErrorStatuses are the statuses of failed instances
+var instanceErrorStatuses = []string{"ERROR"}
+
+// snapshotErrorStatuses are the statuses of failed snapshots
+var snapshotErrorStatuses = []string{"killed", "deleted"}
+
+// WaitOption configures a waiter, such as WaitInstanceStatus
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	interval time.Duration
+	timeout  time.Duration
+	progress func(status string, elapsed time.Duration)
+}
+
+// WithWaitInterval sets the interval between two checks, DefaultWaitInterval by default
+func WithWaitInterval(interval time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.interval = interval
+	}
+}
+
+// WithWaitTimeout stops waiting after timeout. Without timeout, waiters wait
+// until their context is done.
+func WithWaitTimeout(timeout time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithWaitProgress calls progress after each check, with the status read and
+// the time elapsed since the waiter started
+func WithWaitProgress(progress func(status string, elapsed time.Duration)) WaitOption {
+	return func(o *waitOptions) {
+		o.progress = progress
+	}
+}
+
+// StatusError is returned by waiters when a resource reaches a failure status
+type StatusError struct {
+	Kind   string
+	ID     string
+	Status string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s %s is in status %s", e.Kind, e.ID, e.Status)
+}
+
+// Wait calls check until it tells it is done or fails, at the interval and
+// with the timeout of opts. check returns the current status, reported to the
+// progress function of opts. Reaching the timeout returns an error wrapping
+// context.DeadlineExceeded, with the last status.
+func Wait(ctx context.Context, check func(ctx context.Context) (status string, done bool, err error), opts ...WaitOption) error {
+	o := &waitOptions{interval: DefaultWaitInterval}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	waitCtx := ctx
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	status := ""
+	for {
+		s, done, err := check(waitCtx)
+		if err == nil {
+			status = s
+			if o.progress != nil {
+				o.progress(status, time.Since(start))
+			}
+		}
+		switch {
+		case waitCtx.Err() != nil && ctx.Err() == nil:
+			// our own timeout, not the one of the caller
+			return fmt.Errorf("Timeout after %s, last status %s: %w", o.timeout, status, context.DeadlineExceeded)
+		case err != nil || done:
+			return err
+		}
+
+		select {
+		case <-time.After(o.interval):
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("Timeout after %s, last status %s: %w", o.timeout, status, context.DeadlineExceeded)
+		}
+	}
+}
+
+// WaitInstanceStatus waits until an instance reaches status, such as "ACTIVE",
+// and returns it. It fails with a *StatusError when the instance reaches an
+// ERROR status instead.
+func (c *Client) WaitInstanceStatus(ctx context.Context, projectID, instanceID, status string, opts ...WaitOption) (*Instance, error) {
+	var instance *Instance
+	err := Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		i, err := c.CloudInfoInstanceCtx(ctx, projectID, instanceID)
+		if err != nil {
+			return "", false, err
+		}
+		instance = i
+		for _, s := range instanceErrorStatuses {
+			if i.Status == s && s != status {
+				return i.Status, false, &StatusError{Kind: "Instance", ID: instanceID, Status: i.Status}
+			}
+		}
+		return i.Status, i.Status == status, nil
+	}, opts...)
+	return instance, err
+}
+
+// WaitInstanceRebooted waits until a rebooted instance is ACTIVE again, and
+// returns it. The API still reports an instance ACTIVE right after a reboot
+// request, so it first waits, for up to a minute, for the instance to leave
+// ACTIVE. The timeout of opts covers both waits. It fails with a *StatusError
+// when the instance reaches ERROR.
+func (c *Client) WaitInstanceRebooted(ctx context.Context, projectID, instanceID string, opts ...WaitOption) (*Instance, error) {
+	o := &waitOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// a single deadline for both waits, instead of a timeout each
+	waitCtx := ctx
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	opts = append(opts[:len(opts):len(opts)], WithWaitTimeout(0))
+
+	status := ""
+	timeout := func(err error) error {
+		if err != nil && waitCtx.Err() != nil && ctx.Err() == nil {
+			return fmt.Errorf("Timeout after %s, last status %s: %w", o.timeout, status, context.DeadlineExceeded)
+		}
+		return err
+	}
+
+	err := Wait(waitCtx, func(ctx context.Context) (string, bool, error) {
+		i, err := c.CloudInfoInstanceCtx(ctx, projectID, instanceID)
+		if err != nil {
+			return "", false, err
+		}
+		status = i.Status
+		return i.Status, i.Status != "ACTIVE", nil
+	}, append(opts, WithWaitTimeout(instanceRebootStartTimeout))...)
+	if errors.Is(err, context.DeadlineExceeded) && waitCtx.Err() == nil {
+		// the reboot was too quick to be seen, or did not change the status
+		err = nil
+	}
+	if err != nil {
+		return nil, timeout(err)
+	}
+
+	instance, err := c.WaitInstanceStatus(waitCtx, projectID, instanceID, "ACTIVE", opts...)
+	if instance != nil {
+		status = instance.Status
+	}
+	return instance, timeout(err)
+}
+
+// WaitInstanceDeleted waits until an instance is gone. Instances in ERROR are
+// waited for too, as they are the ones most often deleted.
+func (c *Client) WaitInstanceDeleted(ctx context.Context, projectID, instanceID string, opts ...WaitOption) error {
+	return Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		instance, err := c.CloudInfoInstanceCtx(ctx, projectID, instanceID)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return "DELETED", true, nil
+		case err != nil:
+			return "", false, err
+		}
+		// instances in ERROR are still deleted
+		return instance.Status, instance.Status == "DELETED", nil
+	}, opts...)
+}
+
+// WaitSnapshotStatus waits until a snapshot reaches status, such as "active",
+// and returns it. It fails with a *StatusError when the snapshot is killed or
+// deleted instead.
+func (c *Client) WaitSnapshotStatus(ctx context.Context, projectID, snapshotID, status string, opts ...WaitOption) (*Image, error) {
+	var snapshot *Image
+	err := Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		s, err := c.CloudInfoSnapshotCtx(ctx, projectID, snapshotID)
+		if err != nil {
+			return "", false, err
+		}
+		snapshot = s
+		for _, e := range snapshotErrorStatuses {
+			if s.Status == e && e != status {
+				return s.Status, false, &StatusError{Kind: "Snapshot", ID: snapshotID, Status: s.Status}
+			}
+		}
+		return s.Status, s.Status == status, nil
+	}, opts...)
+	return snapshot, err
+}
+
+// WaitVolumeStatus waits until a volume reaches status, such as "available"
+// or "in-use", and returns it. It fails with a *StatusError when the volume
+// reaches an error status instead.
+func (c *Client) WaitVolumeStatus(ctx context.Context, projectID, volumeID, status string, opts ...WaitOption) (*Volume, error) {
+	var volume *Volume
+	err := Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		v, err := c.CloudInfoVolumeCtx(ctx, projectID, volumeID)
+		if err != nil {
+			return "", false, err
+		}
+		volume = v
+		if strings.HasPrefix(v.Status, "error") && v.Status != status {
+			return v.Status, false, &StatusError{Kind: "Volume", ID: volumeID, Status: v.Status}
+		}
+		return v.Status, v.Status == status, nil
+	}, opts...)
+	return volume, err
+}
+
+// WaitNetworkPrivateActive waits until a private network is ACTIVE in all its
+// regions, and returns it. The status reported to WithWaitProgress is the
+// status of each region, such as "GRA3:ACTIVE SBG5:BUILDING". It fails with a
+// *StatusError when a region reaches the ERROR status.
+func (c *Client) WaitNetworkPrivateActive(ctx context.Context, projectID, networkID string, opts ...WaitOption) (*Network, error) {
+	var network *Network
+	err := Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		n, err := c.CloudGetNetworkPrivateCtx(ctx, projectID, networkID)
+		if err != nil {
+			return "", false, err
+		}
+		network = n
+
+		statuses := []string{}
+		active := len(n.Regions) > 0
+		for _, r := range n.Regions {
+			statuses = append(statuses, r.Region+":"+r.Status)
+			if r.Status == "ERROR" {
+				return strings.Join(statuses, " "), false, &StatusError{Kind: "Network", ID: networkID + " in " + r.Region, Status: r.Status}
+			}
+			active = active && r.Status == "ACTIVE"
+		}
+		return strings.Join(statuses, " "), active, nil
+	}, opts...)
+	return network, err
+}
+
+// WaitFailoverIPRouted waits until a failover IP is routed to an instance,
+// with no operation pending, and returns it
+func (c *Client) WaitFailoverIPRouted(ctx context.Context, projectID, ipID, instanceID string, opts ...WaitOption) (*FailoverIP, error) {
+	var ip *FailoverIP
+	err := Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		i, err := c.CloudInfoFailoverIPCtx(ctx, projectID, ipID)
+		if err != nil {
+			return "", false, err
+		}
+		ip = i
+		status := i.Status
+		if i.Status != "ok" {
+			status = fmt.Sprintf("%s %d%%", i.Status, i.Progress)
+		}
+		return status, i.Status == "ok" && i.RoutedTo == instanceID, nil
+	}, opts...)
+	return ip, err
+}
@@ -0,0 +1,126 @@
+package ovh_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ovh "github.com/admdwrf/ovhcli"
+)
+
+func TestWaitInstanceStatus(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+
+	if err := client.CloudRebootInstance(project.ID, instance.ID, ovh.RebootSoft); err != nil {
+		t.Fatal(err)
+	}
+	statuses := []string{}
+	got, err := client.WaitInstanceStatus(context.Background(), project.ID, instance.ID, "ACTIVE",
+		ovh.WithWaitInterval(time.Millisecond),
+		ovh.WithWaitProgress(func(status string, elapsed time.Duration) { statuses = append(statuses, status) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != "ACTIVE" || len(statuses) != 2 || statuses[0] != "REBOOT" {
+		t.Fatalf("expected to see REBOOT then ACTIVE, got %v", statuses)
+	}
+
+	// failures
+	server.SetInstanceStatus(project.ID, instance.ID, "ERROR")
+	_, err = client.WaitInstanceStatus(context.Background(), project.ID, instance.ID, "ACTIVE", ovh.WithWaitInterval(time.Millisecond))
+	var statusErr *ovh.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Status != "ERROR" {
+		t.Fatalf("expected an ERROR status, got %v", err)
+	}
+
+	// timeouts
+	server.SetInstanceStatus(project.ID, instance.ID, "BUILD")
+	_, err = client.WaitInstanceStatus(context.Background(), project.ID, instance.ID, "ACTIVE",
+		ovh.WithWaitInterval(time.Millisecond),
+		ovh.WithWaitTimeout(20*time.Millisecond),
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+
+	// cancellation by the caller
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = client.WaitInstanceStatus(ctx, project.ID, instance.ID, "ACTIVE"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancellation, got %v", err)
+	}
+}
+
+func TestWaitInstanceRebooted(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3", Status: "ACTIVE"})
+
+	// the instance is still ACTIVE for a while after the reboot request
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		client.CloudRebootInstance(project.ID, instance.ID, ovh.RebootHard)
+	}()
+	statuses := []string{}
+	got, err := client.WaitInstanceRebooted(context.Background(), project.ID, instance.ID,
+		ovh.WithWaitInterval(time.Millisecond),
+		ovh.WithWaitProgress(func(status string, elapsed time.Duration) { statuses = append(statuses, status) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(statuses)
+	if got.Status != "ACTIVE" || n < 3 || statuses[0] != "ACTIVE" || statuses[n-2] != "HARD_REBOOT" || statuses[n-1] != "ACTIVE" {
+		t.Fatalf("expected to see ACTIVE, HARD_REBOOT then ACTIVE, got %v", statuses)
+	}
+}
+
+func TestWaitInstanceRebootedTimeout(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3", Status: "ACTIVE"})
+
+	// the reboot starts late and never ends: both waits share the timeout
+	go func() {
+		time.Sleep(120 * time.Millisecond)
+		server.SetInstanceStatus(project.ID, instance.ID, "HARD_REBOOT")
+	}()
+	start := time.Now()
+	_, err := client.WaitInstanceRebooted(context.Background(), project.ID, instance.ID,
+		ovh.WithWaitInterval(time.Millisecond), ovh.WithWaitTimeout(200*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "last status HARD_REBOOT") {
+		t.Fatalf("expected a timeout in HARD_REBOOT, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 300*time.Millisecond {
+		t.Fatalf("expected to wait 200ms at most, waited %s", elapsed)
+	}
+}
+
+func TestWaitInstanceDeleted(t *testing.T) {
+	server, client := newTestServer(t)
+	project := server.AddProject(ovh.Project{Name: "staging"})
+	instance := server.AddInstance(project.ID, ovh.Instance{Name: "web-1", Region: "GRA3"})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		client.CloudDeleteInstance(project.ID, instance.ID)
+	}()
+	if err := client.WaitInstanceDeleted(context.Background(), project.ID, instance.ID, ovh.WithWaitInterval(time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+
+	// instances in ERROR are waited for until deleted
+	errored := server.AddInstance(project.ID, ovh.Instance{Name: "web-2", Region: "GRA3", Status: "ERROR"})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		client.CloudDeleteInstance(project.ID, errored.ID)
+	}()
+	if err := client.WaitInstanceDeleted(context.Background(), project.ID, errored.ID, ovh.WithWaitInterval(time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+}